@@ -2,10 +2,14 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	checkersmodule "github.com/LeTrongDat/checkers/x/checkers"
+	checkersmoduletypes "github.com/LeTrongDat/checkers/x/checkers/types"
+
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -31,6 +35,11 @@ func (app *App) ExportAppStateAndValidators(
 	}
 
 	genState := app.mm.ExportGenesis(ctx, app.appCodec)
+	if forZeroHeight {
+		if err := app.prepCheckersForZeroHeightGenesis(ctx, genState); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	}
 	appState, err := json.MarshalIndent(genState, "", "  ")
 	if err != nil {
 		return servertypes.ExportedApp{}, err
@@ -48,9 +57,25 @@ func (app *App) ExportAppStateAndValidators(
 	}, nil
 }
 
+// checkers deadlines are absolute times, so the restarted chain would forfeit
+// every game at its first block. The time of the last block is recorded so
+// that the deadlines are rebased on the new genesis time. The export fails
+// when that time is unknown, rather than produce such a genesis.
+func (app *App) prepCheckersForZeroHeightGenesis(ctx sdk.Context, genState map[string]json.RawMessage) error {
+	historicalInfo, found := app.StakingKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight())
+	if !found {
+		return fmt.Errorf("no historical info at height %d to rebase the checkers deadlines on, "+
+			"the staking historical_entries param must be above 0", ctx.BlockHeight())
+	}
+	ctx = ctx.WithBlockTime(historicalInfo.Header.Time)
+	genState[checkersmoduletypes.ModuleName] = app.appCodec.MustMarshalJSON(
+		checkersmodule.ExportGenesisForZeroHeight(ctx, app.CheckersKeeper))
+	return nil
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
-//      in favour of export at a block height
+// in favour of export at a block height
 func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) {
 	applyAllowedAddrs := false
 
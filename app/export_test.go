@@ -0,0 +1,54 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/LeTrongDat/checkers/app"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/ignite/cli/ignite/pkg/cosmoscmd"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestExportZeroHeightWithoutHistoricalInfo(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	checkersApp := app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		t.TempDir(),
+		0,
+		encoding,
+		simapp.EmptyAppOptions{},
+	).(*app.App)
+	appState, err := json.Marshal(app.ModuleBasics.DefaultGenesis(encoding.Marshaler))
+	require.NoError(t, err)
+	checkersApp.InitChain(abci.RequestInitChain{
+		ChainId:         "checkers",
+		ConsensusParams: defaultConsensusParams,
+		AppStateBytes:   appState,
+	})
+	checkersApp.Commit()
+
+	// No block was begun, so staking has no header to give the time of
+	_, err = checkersApp.ExportAppStateAndValidators(true, []string{})
+	require.EqualError(t, err, "no historical info at height 1 to rebase the checkers deadlines on, "+
+		"the staking historical_entries param must be above 0")
+
+	_, err = checkersApp.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+
+	header := tmproto.Header{ChainID: "checkers", Height: 2, Time: time.Unix(1_700_000_000, 0).UTC()}
+	checkersApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	checkersApp.EndBlock(abci.RequestEndBlock{Height: 2})
+	checkersApp.Commit()
+	_, err = checkersApp.ExportAppStateAndValidators(true, []string{})
+	require.NoError(t, err)
+}
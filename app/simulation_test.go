@@ -111,3 +111,56 @@ func BenchmarkSimulation(b *testing.B) {
 		simapp.PrintStats(db)
 	}
 }
+
+// TestFullAppSimulation runs the chain simulation with the invariants checked
+// every -Period blocks. It is skipped unless -Enabled is set:
+// `go test ./app -run TestFullAppSimulation -Enabled=true -NumBlocks=100 -BlockSize=50 -Period=5 -Seed=42 -v`
+func TestFullAppSimulation(t *testing.T) {
+	// blocks are only checked against the last committed height
+	simapp.FlagCommitValue = true
+
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	t.Cleanup(func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	encoding := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+
+	app := app.New(
+		logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		simapp.FlagPeriodValue,
+		encoding,
+		simapp.EmptyAppOptions{},
+	)
+
+	simApp, ok := app.(SimApp)
+	require.True(t, ok, "can't use simapp")
+
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		simApp.GetBaseApp(),
+		simapp.AppStateFn(simApp.AppCodec(), simApp.SimulationManager()),
+		simulationtypes.RandomAccounts,
+		simapp.SimulationOperations(simApp, simApp.AppCodec(), config),
+		simApp.ModuleAccountAddrs(),
+		config,
+		simApp.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	err = simapp.CheckExportSimulation(simApp, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+}
@@ -0,0 +1,24 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	// CheckersV3UpgradeName is the upgrade that migrates the checkers state to
+	// its version 3, with invitations and per-player deposits.
+	CheckersV3UpgradeName = "v3"
+)
+
+// setupUpgradeHandlers registers the in-place store migrations run at each
+// upgrade height.
+func (app *App) setupUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		CheckersV3UpgradeName,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+}
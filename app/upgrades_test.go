@@ -0,0 +1,82 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/LeTrongDat/checkers/app"
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	checkerstypes "github.com/LeTrongDat/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/ignite/cli/ignite/pkg/cosmoscmd"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestCheckersV3Upgrade(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	checkersApp := app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		t.TempDir(),
+		0,
+		encoding,
+		simapp.EmptyAppOptions{},
+	).(*app.App)
+	appState, err := json.Marshal(app.ModuleBasics.DefaultGenesis(encoding.Marshaler))
+	require.NoError(t, err)
+	checkersApp.InitChain(abci.RequestInitChain{
+		ChainId:         "checkers",
+		ConsensusParams: defaultConsensusParams,
+		AppStateBytes:   appState,
+	})
+	ctx := checkersApp.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	// Populate the store as version 2 left it
+	k := checkersApp.CheckersKeeper
+	k.SetStoredGame(ctx, checkerstypes.StoredGame{
+		Index:       "1",
+		Board:       rules.New().String(),
+		Turn:        "r",
+		Black:       "cosmos1p5tkxnnhh94lvju6pv4c8tg767a2207l89rpx2",
+		Red:         "cosmos16ur8ptycskmasf8jhuh6mlvrwq6ndwq47zqnar",
+		MoveCount:   2,
+		BeforeIndex: "-1",
+		AfterIndex:  "-1",
+		Deadline:    "2022-06-01 10:00:00 +0000 UTC",
+		Winner:      "*",
+		Wager:       15,
+	})
+	k.SetSystemInfo(ctx, checkerstypes.SystemInfo{
+		NextId:        2,
+		FifoHeadIndex: "1",
+		FifoTailIndex: "1",
+	})
+	fromVM := checkersApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.EqualValues(t, 3, fromVM[checkerstypes.ModuleName])
+	fromVM[checkerstypes.ModuleName] = 2
+	checkersApp.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM)
+
+	checkersApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{
+		Name:   app.CheckersV3UpgradeName,
+		Height: 10,
+	})
+
+	require.EqualValues(t, 3, checkersApp.UpgradeKeeper.GetModuleVersionMap(ctx)[checkerstypes.ModuleName])
+	systemInfo, found := k.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.Equal(t, checkerstypes.NoFifoIndex, systemInfo.InviteHeadIndex)
+	require.Equal(t, checkerstypes.NoFifoIndex, systemInfo.InviteTailIndex)
+	storedGame, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, 15, storedGame.BlackDeposit)
+	require.EqualValues(t, 15, storedGame.RedDeposit)
+	require.EqualValues(t, checkerstypes.DefaultParams(), k.GetParams(ctx))
+}
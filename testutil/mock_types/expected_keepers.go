@@ -7,8 +7,9 @@ package mock_types
 import (
 	reflect "reflect"
 
-	types "github.com/cosmos/cosmos-sdk/types"
-	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
+	types "github.com/LeTrongDat/checkers/x/checkers/types"
+	types0 "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,10 +37,10 @@ func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
 }
 
 // GetAccount mocks base method.
-func (m *MockAccountKeeper) GetAccount(ctx types.Context, addr types.AccAddress) types0.AccountI {
+func (m *MockAccountKeeper) GetAccount(ctx types0.Context, addr types0.AccAddress) types1.AccountI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAccount", ctx, addr)
-	ret0, _ := ret[0].(types0.AccountI)
+	ret0, _ := ret[0].(types1.AccountI)
 	return ret0
 }
 
@@ -73,10 +74,10 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 }
 
 // SpendableCoins mocks base method.
-func (m *MockBankKeeper) SpendableCoins(ctx types.Context, addr types.AccAddress) types.Coins {
+func (m *MockBankKeeper) SpendableCoins(ctx types0.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SpendableCoins", ctx, addr)
-	ret0, _ := ret[0].(types.Coins)
+	ret0, _ := ret[0].(types0.Coins)
 	return ret0
 }
 
@@ -109,8 +110,22 @@ func (m *MockBankEscrowKeeper) EXPECT() *MockBankEscrowKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetBalance mocks base method.
+func (m *MockBankEscrowKeeper) GetBalance(ctx types0.Context, addr types0.AccAddress, denom string) types0.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, addr, denom)
+	ret0, _ := ret[0].(types0.Coin)
+	return ret0
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockBankEscrowKeeperMockRecorder) GetBalance(ctx, addr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankEscrowKeeper)(nil).GetBalance), ctx, addr, denom)
+}
+
 // SendCoinsFromAccountToModule mocks base method.
-func (m *MockBankEscrowKeeper) SendCoinsFromAccountToModule(ctx types.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
+func (m *MockBankEscrowKeeper) SendCoinsFromAccountToModule(ctx types0.Context, senderAddr types0.AccAddress, recipientModule string, amt types0.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
 	ret0, _ := ret[0].(error)
@@ -124,7 +139,7 @@ func (mr *MockBankEscrowKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, se
 }
 
 // SendCoinsFromModuleToAccount mocks base method.
-func (m *MockBankEscrowKeeper) SendCoinsFromModuleToAccount(ctx types.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+func (m *MockBankEscrowKeeper) SendCoinsFromModuleToAccount(ctx types0.Context, senderModule string, recipientAddr types0.AccAddress, amt types0.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
 	ret0, _ := ret[0].(error)
@@ -136,3 +151,62 @@ func (mr *MockBankEscrowKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, se
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankEscrowKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
+
+// MockCheckersHooks is a mock of CheckersHooks interface.
+type MockCheckersHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckersHooksMockRecorder
+}
+
+// MockCheckersHooksMockRecorder is the mock recorder for MockCheckersHooks.
+type MockCheckersHooksMockRecorder struct {
+	mock *MockCheckersHooks
+}
+
+// NewMockCheckersHooks creates a new mock instance.
+func NewMockCheckersHooks(ctrl *gomock.Controller) *MockCheckersHooks {
+	mock := &MockCheckersHooks{ctrl: ctrl}
+	mock.recorder = &MockCheckersHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckersHooks) EXPECT() *MockCheckersHooksMockRecorder {
+	return m.recorder
+}
+
+// AfterGameCreated mocks base method.
+func (m *MockCheckersHooks) AfterGameCreated(ctx types0.Context, storedGame types.StoredGame) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AfterGameCreated", ctx, storedGame)
+}
+
+// AfterGameCreated indicates an expected call of AfterGameCreated.
+func (mr *MockCheckersHooksMockRecorder) AfterGameCreated(ctx, storedGame interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterGameCreated", reflect.TypeOf((*MockCheckersHooks)(nil).AfterGameCreated), ctx, storedGame)
+}
+
+// AfterGameEnded mocks base method.
+func (m *MockCheckersHooks) AfterGameEnded(ctx types0.Context, storedGame types.StoredGame, winner, loser types0.AccAddress, reason string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AfterGameEnded", ctx, storedGame, winner, loser, reason)
+}
+
+// AfterGameEnded indicates an expected call of AfterGameEnded.
+func (mr *MockCheckersHooksMockRecorder) AfterGameEnded(ctx, storedGame, winner, loser, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterGameEnded", reflect.TypeOf((*MockCheckersHooks)(nil).AfterGameEnded), ctx, storedGame, winner, loser, reason)
+}
+
+// AfterMovePlayed mocks base method.
+func (m *MockCheckersHooks) AfterMovePlayed(ctx types0.Context, storedGame types.StoredGame, player types0.AccAddress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AfterMovePlayed", ctx, storedGame, player)
+}
+
+// AfterMovePlayed indicates an expected call of AfterMovePlayed.
+func (mr *MockCheckersHooksMockRecorder) AfterMovePlayed(ctx, storedGame, player interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterMovePlayed", reflect.TypeOf((*MockCheckersHooks)(nil).AfterMovePlayed), ctx, storedGame, player)
+}
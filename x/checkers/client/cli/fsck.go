@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagRebuild = "rebuild"
+	flagOutput  = "output-document"
+)
+
+// CmdCheckers groups the offline commands of the checkers module, which work
+// without a node.
+func CmdCheckers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Offline tools for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdFsck())
+
+	return cmd
+}
+
+func CmdFsck() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fsck [genesis-file]",
+		Short: "Checks the game queues of an exported genesis",
+		Long: `Checks that the deadline FIFO and the list of pending invitations of an exported
+genesis are well linked, hold exactly the games they should, and are sorted by deadline.
+With --rebuild, both lists are linked again from scratch and the genesis is saved to
+--output-document, or in place when it is not set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			rebuild, err := cmd.Flags().GetBool(flagRebuild)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				output = args[0]
+			}
+
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return err
+			}
+			var genState types.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[types.ModuleName], &genState); err != nil {
+				return err
+			}
+			if genState.SystemInfo == nil {
+				return fmt.Errorf("no system info in the %s genesis", types.ModuleName)
+			}
+
+			games := map[string]types.StoredGame{}
+			for _, storedGame := range genState.StoredGameList {
+				games[storedGame.Index] = storedGame
+			}
+			problems := types.FifoProblems(*genState.SystemInfo, games)
+			for _, problem := range problems {
+				fmt.Fprintln(cmd.OutOrStdout(), problem)
+			}
+			if len(problems) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "The game queues are consistent.")
+				return nil
+			}
+			if !rebuild {
+				return fmt.Errorf("found %d problems, run again with --%s to fix them", len(problems), flagRebuild)
+			}
+
+			if err := types.RebuildFifo(genState.SystemInfo, genState.StoredGameList); err != nil {
+				return err
+			}
+			appState[types.ModuleName], err = clientCtx.Codec.MarshalJSON(&genState)
+			if err != nil {
+				return err
+			}
+			genDoc.AppState, err = json.MarshalIndent(appState, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := genutil.ExportGenesisFile(genDoc, output); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Rebuilt the game queues into %s.\n", output)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagRebuild, false, "Link the game queues again from scratch when they have problems")
+	cmd.Flags().String(flagOutput, "", "File to save the rebuilt genesis to, instead of the genesis file")
+
+	return cmd
+}
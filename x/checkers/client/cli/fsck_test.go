@@ -0,0 +1,106 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/LeTrongDat/checkers/testutil/network"
+	"github.com/LeTrongDat/checkers/x/checkers/client/cli"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+)
+
+func writeGenesisWithGames(t *testing.T, cfg network.Config, systemInfo types.SystemInfo, games []types.StoredGame) string {
+	t.Helper()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	state.SystemInfo = &systemInfo
+	state.StoredGameList = games
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	appState, err := json.Marshal(cfg.GenesisState)
+	require.NoError(t, err)
+
+	genFile := filepath.Join(t.TempDir(), "genesis.json")
+	genDoc := &tmtypes.GenesisDoc{ChainID: cfg.ChainID, AppState: appState}
+	require.NoError(t, genDoc.SaveAs(genFile))
+	return genFile
+}
+
+func getBrokenFifo() (types.SystemInfo, []types.StoredGame) {
+	return types.SystemInfo{
+		NextId:          3,
+		FifoHeadIndex:   "2",
+		FifoTailIndex:   "2",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
+	}, []types.StoredGame{
+		{Index: "1", Winner: "*", Deadline: "2022-06-01 10:00:00 +0000 UTC", BeforeIndex: "-1", AfterIndex: "-1"},
+		{Index: "2", Winner: "*", Deadline: "2022-06-01 11:00:00 +0000 UTC", BeforeIndex: "-1", AfterIndex: "-1"},
+	}
+}
+
+func TestFsckConsistent(t *testing.T) {
+	cfg := network.DefaultConfig()
+	genFile := writeGenesisWithGames(t, cfg, types.SystemInfo{
+		NextId:          1,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
+	}, nil)
+	ctx := client.Context{}.WithCodec(cfg.Codec)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdFsck(), []string{genFile})
+	require.NoError(t, err)
+	require.Equal(t, "The game queues are consistent.\n", out.String())
+}
+
+func TestFsckReportsProblems(t *testing.T) {
+	cfg := network.DefaultConfig()
+	systemInfo, games := getBrokenFifo()
+	genFile := writeGenesisWithGames(t, cfg, systemInfo, games)
+	ctx := client.Context{}.WithCodec(cfg.Codec)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdFsck(), []string{genFile})
+	require.EqualError(t, err, "found 1 problems, run again with --rebuild to fix them")
+	require.True(t, strings.HasPrefix(out.String(), "fifo: game 1 is missing\nError: "))
+}
+
+func TestFsckRebuilds(t *testing.T) {
+	cfg := network.DefaultConfig()
+	systemInfo, games := getBrokenFifo()
+	genFile := writeGenesisWithGames(t, cfg, systemInfo, games)
+	output := filepath.Join(t.TempDir(), "fixed.json")
+	ctx := client.Context{}.WithCodec(cfg.Codec)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdFsck(), []string{genFile, "--rebuild", "--output-document", output})
+	require.NoError(t, err)
+	require.Equal(t, "fifo: game 1 is missing\nRebuilt the game queues into "+output+".\n", out.String())
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(output)
+	require.NoError(t, err)
+	var state types.GenesisState
+	require.NoError(t, cfg.Codec.UnmarshalJSON(appState[types.ModuleName], &state))
+	require.EqualValues(t, types.SystemInfo{
+		NextId:          3,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "2",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
+	}, *state.SystemInfo)
+	require.Equal(t, "2", state.StoredGameList[0].AfterIndex)
+	require.Equal(t, "1", state.StoredGameList[1].BeforeIndex)
+
+	out, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdFsck(), []string{output})
+	require.NoError(t, err)
+	require.Equal(t, "The game queues are consistent.\n", out.String())
+}
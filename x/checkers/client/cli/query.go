@@ -28,6 +28,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowSystemInfo())
 	cmd.AddCommand(CmdListStoredGame())
 	cmd.AddCommand(CmdShowStoredGame())
+	cmd.AddCommand(CmdSuggestMove())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
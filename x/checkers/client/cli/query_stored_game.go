@@ -2,6 +2,9 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/LeTrongDat/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,6 +12,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const flagRender = "render"
+
+func addRenderFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagRender, false, "Draw the games instead of printing them as data")
+}
+
 func CmdListStoredGame() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-stored-game",
@@ -32,10 +41,23 @@ func CmdListStoredGame() *cobra.Command {
 				return err
 			}
 
+			if render, _ := cmd.Flags().GetBool(flagRender); render {
+				now := time.Now()
+				rendered := make([]string, 0, len(res.StoredGame))
+				for _, storedGame := range res.StoredGame {
+					out, err := storedGame.Render(now)
+					if err != nil {
+						out = fmt.Sprintf("Game %s cannot be drawn: %s\n", storedGame.Index, err)
+					}
+					rendered = append(rendered, out)
+				}
+				return clientCtx.PrintString(strings.Join(rendered, "\n"))
+			}
 			return clientCtx.PrintProto(res)
 		},
 	}
 
+	addRenderFlag(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -63,10 +85,18 @@ func CmdShowStoredGame() *cobra.Command {
 				return err
 			}
 
+			if render, _ := cmd.Flags().GetBool(flagRender); render {
+				out, err := res.StoredGame.Render(time.Now())
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintString(out)
+			}
 			return clientCtx.PrintProto(res)
 		},
 	}
 
+	addRenderFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -29,7 +29,9 @@ func networkWithStoredGameObjects(t *testing.T, n int) (*network.Network, []type
 
 	for i := 0; i < n; i++ {
 		storedGame := types.StoredGame{
-			Index: strconv.Itoa(i),
+			Index:       strconv.Itoa(i),
+			BeforeIndex: types.NoFifoIndex,
+			AfterIndex:  types.NoFifoIndex,
 		}
 		nullify.Fill(&storedGame)
 		state.StoredGameList = append(state.StoredGameList, storedGame)
@@ -159,3 +161,19 @@ func TestListStoredGame(t *testing.T) {
 		)
 	})
 }
+
+func TestRenderStoredGames(t *testing.T) {
+	net := networkWithPlayableGame(t)
+	ctx := net.Validators[0].ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowStoredGame(), []string{"1", "--render"})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "Game 1, american rules, 0 moves played\n")
+	require.Contains(t, out.String(), " 8    b   .   .   .  8    1  2  3  4\n")
+	require.Contains(t, out.String(), "To move:  black\n")
+
+	out, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdListStoredGame(), []string{"--render"})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "Game 1, american rules, 0 moves played\n")
+	require.Contains(t, out.String(), "Wager:    0stake\n")
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LeTrongDat/checkers/x/checkers/engine"
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagDepth = "depth"
+	flagTime  = "time"
+)
+
+func CmdSuggestMove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suggest-move [index]",
+		Short: "Searches the best move of a storedGame, offline",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			budget, err := getBudget(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StoredGame(context.Background(), &types.QueryGetStoredGameRequest{
+				Index: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			if res.StoredGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+				return errors.New(fmt.Sprintf("game %s is already finished", args[0]))
+			}
+			game, err := res.StoredGame.ParseGame()
+			if err != nil {
+				return err
+			}
+
+			result, err := engine.Search(game, budget)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("move: %s\nscore: %d\ndepth: %d\n",
+				result.Move.Notation(game.Variant), result.Score, result.Depth))
+		},
+	}
+
+	addBudgetFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addBudgetFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flagDepth, engine.DEFAULT_DEPTH, "Maximum depth of the search, in moves, or 0 to only stop on time")
+	cmd.Flags().Duration(flagTime, 0, "Maximum duration of the search, e.g. 5s")
+}
+
+func getBudget(cmd *cobra.Command) (budget engine.Budget, err error) {
+	if budget.Depth, err = cmd.Flags().GetInt(flagDepth); err != nil {
+		return budget, err
+	}
+	budget.Time, err = cmd.Flags().GetDuration(flagTime)
+	return budget, err
+}
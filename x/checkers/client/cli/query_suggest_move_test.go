@@ -0,0 +1,54 @@
+package cli_test
+
+import (
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/LeTrongDat/checkers/testutil/network"
+	"github.com/LeTrongDat/checkers/x/checkers/client/cli"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+)
+
+func networkWithPlayableGame(t *testing.T) *network.Network {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	state.StoredGameList = append(state.StoredGameList, types.StoredGame{
+		Index:       "1",
+		Board:       "*b******|**r*****|********|****r***|********|********|********|******r*",
+		Turn:        "b",
+		Black:       "cosmos1p5tkxnnhh94lvju6pv4c8tg767a2207l89rpx2",
+		Red:         "cosmos16ur8ptycskmasf8jhuh6mlvrwq6ndwq47zqnar",
+		BeforeIndex: types.NoFifoIndex,
+		AfterIndex:  types.NoFifoIndex,
+		Deadline:    "2100-01-01 00:00:00 +0000 UTC",
+		Winner:      "*",
+		Variant:     "american",
+	})
+	state.SystemInfo.NextId = 2
+	state.SystemInfo.FifoHeadIndex = "1"
+	state.SystemInfo.FifoTailIndex = "1"
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg)
+}
+
+func TestSuggestMove(t *testing.T) {
+	net := networkWithPlayableGame(t)
+	ctx := net.Validators[0].ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdSuggestMove(), []string{"1", "--depth=3"})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "move: 1x10x19\n")
+
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdSuggestMove(), []string{"2"})
+	stat, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, stat.Code())
+}
@@ -33,6 +33,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateGame())
 	cmd.AddCommand(CmdPlayMove())
 	cmd.AddCommand(CmdRejectGame())
+	cmd.AddCommand(CmdAcceptInvite())
+	cmd.AddCommand(CmdBot())
+	cmd.AddCommand(CmdPlay())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,267 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/LeTrongDat/checkers/x/checkers/engine"
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const (
+	flagPoll = "poll"
+	flagOnce = "once"
+)
+
+const botSubscriber = "checkers-bot"
+
+func CmdBot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bot",
+		Short: "Plays the moves of the --from key in all its games, with the built-in engine",
+		Long: `Plays the moves of the --from key in all its games, with the built-in engine.
+The bot listens to the events of the node, and also looks at its games every --poll
+in case it missed an event. Capture sequences are sent as one transaction.
+With --once, it plays the pending moves and exits.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			budget, err := getBudget(cmd)
+			if err != nil {
+				return err
+			}
+			poll, err := cmd.Flags().GetDuration(flagPoll)
+			if err != nil {
+				return err
+			}
+			once, err := cmd.Flags().GetBool(flagOnce)
+			if err != nil {
+				return err
+			}
+			maxRetries, err := cmd.Flags().GetInt(flagMaxRetries)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			sender := newTxSender(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()), maxRetries)
+			b := newBot(clientCtx, sender, cmd.OutOrStdout())
+			b.budget = budget
+			b.retryAfter = poll
+			if once {
+				return b.scan(ctx)
+			}
+			return b.run(ctx, poll)
+		},
+	}
+
+	addBudgetFlags(cmd)
+	cmd.Flags().Duration(flagPoll, 30*time.Second, "Interval between two looks at all the games of the bot")
+	cmd.Flags().Bool(flagOnce, false, "Play the pending moves and exit")
+	addMaxRetriesFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// pendingMove is a move that was broadcast and is not yet seen in the stored game.
+type pendingMove struct {
+	moveCount uint64
+	sent      time.Time
+}
+
+type bot struct {
+	clientCtx  client.Context
+	sender     *txSender
+	out        io.Writer
+	player     string
+	budget     engine.Budget
+	retryAfter time.Duration
+	games      map[string]bool
+	pending    map[string]pendingMove
+}
+
+func newBot(clientCtx client.Context, sender *txSender, out io.Writer) *bot {
+	return &bot{
+		clientCtx:  clientCtx,
+		sender:     sender,
+		out:        out,
+		player:     clientCtx.GetFromAddress().String(),
+		retryAfter: 30 * time.Second,
+		games:      make(map[string]bool),
+		pending:    make(map[string]pendingMove),
+	}
+}
+
+func (b *bot) logf(format string, args ...interface{}) {
+	fmt.Fprintf(b.out, "%s %s\n", time.Now().UTC().Format(time.RFC3339), fmt.Sprintf(format, args...))
+}
+
+// run plays until the context is done.
+func (b *bot) run(ctx context.Context, poll time.Duration) error {
+	events, err := b.subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	if err := b.scan(ctx); err != nil {
+		b.logf("scan failed: %s", err)
+	}
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := b.scan(ctx); err != nil {
+				b.logf("scan failed: %s", err)
+			}
+		case event := <-events:
+			for _, index := range b.gamesOf(event) {
+				b.refresh(ctx, index)
+			}
+		}
+	}
+}
+
+// subscribe listens to the creation of games of the bot, and to all moves, as
+// the node limits the number of subscriptions of a client.
+func (b *bot) subscribe(ctx context.Context) (<-chan ctypes.ResultEvent, error) {
+	node, err := b.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	if !node.IsRunning() {
+		if err := node.Start(); err != nil {
+			return nil, err
+		}
+		go func() {
+			<-ctx.Done()
+			node.Stop()
+		}()
+	}
+	queries := []string{
+		fmt.Sprintf("tm.event='Tx' AND %s.%s='%s'", types.GameCreatedEventType, types.GameCreatedEventBlack, b.player),
+		fmt.Sprintf("tm.event='Tx' AND %s.%s='%s'", types.GameCreatedEventType, types.GameCreatedEventRed, b.player),
+		fmt.Sprintf("tm.event='Tx' AND %s.%s EXISTS", types.MovePlayedEventType, types.MovePlayedEventGameIndex),
+	}
+	events := make(chan ctypes.ResultEvent)
+	for _, query := range queries {
+		out, err := node.Subscribe(ctx, botSubscriber, query)
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			for event := range out {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	return events, nil
+}
+
+// gamesOf returns the games of the bot that an event is about.
+func (b *bot) gamesOf(event ctypes.ResultEvent) []string {
+	indices := []string{}
+	for _, index := range event.Events[types.GameCreatedEventType+"."+types.GameCreatedEventGameIndex] {
+		indices = append(indices, index)
+	}
+	for _, index := range event.Events[types.MovePlayedEventType+"."+types.MovePlayedEventGameIndex] {
+		if b.games[index] {
+			indices = append(indices, index)
+		}
+	}
+	return indices
+}
+
+// scan looks for the games of the bot among all games, and plays where it is its turn.
+func (b *bot) scan(ctx context.Context) error {
+	queryClient := types.NewQueryClient(b.clientCtx)
+	pagination := &query.PageRequest{}
+	for {
+		res, err := queryClient.StoredGameAll(ctx, &types.QueryAllStoredGameRequest{Pagination: pagination})
+		if err != nil {
+			return err
+		}
+		for _, storedGame := range res.StoredGame {
+			b.play(ctx, storedGame)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nil
+		}
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+func (b *bot) refresh(ctx context.Context, index string) {
+	res, err := types.NewQueryClient(b.clientCtx).StoredGame(ctx, &types.QueryGetStoredGameRequest{Index: index})
+	if err != nil {
+		b.logf("game %s: %s", index, err)
+		return
+	}
+	b.play(ctx, res.StoredGame)
+}
+
+// play sends the move of the engine if it is the turn of the bot, and it did
+// not already send it.
+func (b *bot) play(ctx context.Context, storedGame types.StoredGame) {
+	index := storedGame.Index
+	if storedGame.Black != b.player && storedGame.Red != b.player {
+		return
+	}
+	b.games[index] = true
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		delete(b.games, index)
+		delete(b.pending, index)
+		return
+	}
+	// Accepting an invitation is left to the owner of the key
+	if storedGame.IsPending() || !storedGame.HasTurn(b.player) {
+		return
+	}
+	if sent, found := b.pending[index]; found && sent.moveCount == storedGame.MoveCount && time.Since(sent.sent) < b.retryAfter {
+		return
+	}
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		b.logf("game %s: %s", index, err)
+		return
+	}
+	move, err := engine.BestMove(game, b.budget)
+	if err != nil {
+		b.logf("game %s: %s", index, err)
+		return
+	}
+	res, err := b.sender.broadcast(ctx, playMoveMsgs(b.player, index, move.Steps))
+	if err != nil {
+		b.logf("game %s: move %s failed: %s", index, move.Notation(game.Variant), err)
+		return
+	}
+	b.pending[index] = pendingMove{storedGame.MoveCount, time.Now()}
+	b.logf("game %s: played %s in %s", index, move.Notation(game.Variant), res.TxHash)
+}
@@ -0,0 +1,49 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/LeTrongDat/checkers/testutil/network"
+	"github.com/LeTrongDat/checkers/x/checkers/client/cli"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+)
+
+func TestBotPlaysOnce(t *testing.T) {
+	net := network.New(t, network.DefaultConfig())
+	val := net.Validators[0]
+	ctx := val.ClientCtx
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(net.Config.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	args := append([]string{val.Address.String(), "cosmos16ur8ptycskmasf8jhuh6mlvrwq6ndwq47zqnar", "0"}, txFlags...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdCreateGame(), args)
+	require.NoError(t, err)
+
+	args = append([]string{"--once", "--depth=2"}, txFlags...)
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdBot(), args)
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "game 1: played ")
+
+	out, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdShowStoredGame(), []string{"1", fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	require.NoError(t, err)
+	var res types.QueryGetStoredGameResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &res))
+	require.EqualValues(t, 1, res.StoredGame.MoveCount)
+	require.Equal(t, "r", res.StoredGame.Turn)
+
+	// It is not the turn of the bot any more
+	out, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdBot(), args)
+	require.NoError(t, err)
+	require.NotContains(t, out.String(), "played")
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"strconv"
 
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
 	"github.com/LeTrongDat/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -12,6 +13,8 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const flagVariant = "variant"
+
 func CmdCreateGame() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-game [black] [red] [wager]",
@@ -25,6 +28,11 @@ func CmdCreateGame() *cobra.Command {
 				return err
 			}
 
+			argVariant, err := cmd.Flags().GetString(flagVariant)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -35,6 +43,7 @@ func CmdCreateGame() *cobra.Command {
 				argBlack,
 				argRed,
 				argWager,
+				argVariant,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -43,6 +52,7 @@ func CmdCreateGame() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagVariant, rules.DEFAULT_VARIANT.Name, "Rules variant of the game")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
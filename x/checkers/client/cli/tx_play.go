@@ -0,0 +1,242 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/LeTrongDat/checkers/x/checkers/engine"
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+// hintBudget keeps hints quick enough for an interactive session
+var hintBudget = engine.Budget{Depth: engine.DEFAULT_DEPTH, Time: 2 * time.Second}
+
+func CmdPlay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "play [game-index]",
+		Short: "Plays a game with the --from key in the terminal",
+		Long: `Plays a game with the --from key in the terminal.
+The board is drawn with black at the top. Moves are entered in numbered notation,
+such as 11-15 or 11x18x25 for a capture sequence, or in algebraic notation, such as
+f6-e5. Enter hint to get a suggestion from the built-in engine, or quit to leave.
+When it is the turn of the opponent, the game is looked at every --poll.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			poll, err := cmd.Flags().GetDuration(flagPoll)
+			if err != nil {
+				return err
+			}
+			maxRetries, err := cmd.Flags().GetInt(flagMaxRetries)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			input := clientCtx.Input
+			if input == nil {
+				input = cmd.InOrStdin()
+			}
+			session := &playSession{
+				clientCtx: clientCtx,
+				sender:    newTxSender(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()), maxRetries),
+				in:        bufio.NewReader(input),
+				out:       cmd.OutOrStdout(),
+				player:    clientCtx.GetFromAddress().String(),
+				index:     args[0],
+				poll:      poll,
+			}
+			return session.run(ctx)
+		},
+	}
+
+	cmd.Flags().Duration(flagPoll, 2*time.Second, "Interval between two looks at the game while waiting")
+	addMaxRetriesFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+type playSession struct {
+	clientCtx client.Context
+	sender    *txSender
+	in        *bufio.Reader
+	out       io.Writer
+	player    string
+	index     string
+	poll      time.Duration
+}
+
+func (session *playSession) run(ctx context.Context) error {
+	for {
+		storedGame, err := session.fetch(ctx)
+		if err != nil {
+			return err
+		}
+		if storedGame.Black != session.player && storedGame.Red != session.player {
+			return errors.New(fmt.Sprintf("%s does not play game %s", session.player, session.index))
+		}
+		if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+			fmt.Fprintf(session.out, "Game %s is over, the winner is %s.\n", session.index, rules.StringPieces[storedGame.Winner].Player.Name())
+			return nil
+		}
+		game, err := storedGame.ParseGame()
+		if err != nil {
+			return err
+		}
+		session.show(storedGame, game)
+
+		if !storedGame.HasTurn(session.player) {
+			fmt.Fprintf(session.out, "Waiting for %s to play...\n", rules.StringPieces[storedGame.Turn].Player.Name())
+			if err := session.waitChange(ctx, storedGame.MoveCount); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			continue
+		}
+
+		steps, quit := session.prompt(game)
+		if quit {
+			return nil
+		}
+		res, err := session.sender.broadcast(ctx, playMoveMsgs(session.player, session.index, steps))
+		if err == nil {
+			fmt.Fprintf(session.out, "Sent in %s, waiting for the next block...\n", res.TxHash)
+			err = session.sender.waitIncluded(ctx, res, session.poll)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			fmt.Fprintf(session.out, "The move failed: %s\n", err)
+		}
+	}
+}
+
+func (session *playSession) fetch(ctx context.Context) (types.StoredGame, error) {
+	res, err := types.NewQueryClient(session.clientCtx).StoredGame(ctx, &types.QueryGetStoredGameRequest{
+		Index: session.index,
+	})
+	if err != nil {
+		return types.StoredGame{}, err
+	}
+	return res.StoredGame, nil
+}
+
+func (session *playSession) show(storedGame types.StoredGame, game *rules.Game) {
+	fmt.Fprintf(session.out, "\nGame %s, %s rules, move %d\n", session.index, game.Variant, storedGame.MoveCount+1)
+	fmt.Fprint(session.out, rules.Render(game))
+	for _, color := range []string{rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]} {
+		address := storedGame.Black
+		if color == rules.PieceStrings[rules.RED_PLAYER] {
+			address = storedGame.Red
+		}
+		marks := []string{}
+		if address == session.player {
+			marks = append(marks, "you")
+		}
+		if storedGame.Turn == color {
+			marks = append(marks, "to move")
+		}
+		line := fmt.Sprintf("%-5s %s", rules.StringPieces[color].Player.Name()+":", address)
+		if len(marks) > 0 {
+			line += " (" + strings.Join(marks, ", ") + ")"
+		}
+		fmt.Fprintln(session.out, line)
+	}
+	if game.Capturing != rules.NO_POS {
+		fmt.Fprintf(session.out, "The piece on %d has to go on capturing.\n", game.Variant.SquareNumber(game.Capturing))
+	}
+}
+
+// prompt reads moves until one is valid, and returns its steps, or quit at
+// the end of the input.
+func (session *playSession) prompt(game *rules.Game) (steps []rules.Step, quit bool) {
+	for {
+		fmt.Fprint(session.out, "Your move (11-15, f6-e5, hint, quit): ")
+		line, err := session.in.ReadString('\n')
+		line = strings.TrimSpace(line)
+		if line == "" && err != nil {
+			fmt.Fprintln(session.out)
+			return nil, true
+		}
+		switch line {
+		case "":
+			continue
+		case "quit", "exit":
+			return nil, true
+		case "hint":
+			move, err := engine.BestMove(game, hintBudget)
+			if err != nil {
+				fmt.Fprintf(session.out, "No hint: %s\n", err)
+			} else {
+				fmt.Fprintf(session.out, "Hint: %s\n", move.Notation(game.Variant))
+			}
+			continue
+		}
+		steps, err = game.Variant.ParseMove(line)
+		if err == nil {
+			err = checkSteps(game, steps)
+		}
+		if err != nil {
+			fmt.Fprintf(session.out, "%s\n", err)
+			continue
+		}
+		return steps, false
+	}
+}
+
+// checkSteps plays the steps on a copy of the game, so that an invalid move
+// is not broadcast.
+func checkSteps(game *rules.Game, steps []rules.Step) error {
+	check := game.Copy()
+	for _, step := range steps {
+		if _, err := check.Move(step.Src, step.Dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitChange waits for the game to change after a move of the opponent, or
+// the end of the game.
+func (session *playSession) waitChange(ctx context.Context, moveCount uint64) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(session.poll):
+		}
+		storedGame, err := session.fetch(ctx)
+		if err != nil {
+			return err
+		}
+		if storedGame.MoveCount != moveCount || storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+			return nil
+		}
+	}
+}
@@ -15,11 +15,29 @@ var _ = strconv.Itoa(0)
 
 func CmdPlayMove() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "play-move [game-index] [from-x] [from-y] [to-x] [to-y]",
+		Use:   "play-move [game-index] [from-x] [from-y] [to-x] [to-y] | [game-index] [move]",
 		Short: "Broadcast message playMove",
-		Args:  cobra.ExactArgs(5),
+		Long: `Broadcast message playMove, with the coordinates of a single step, or with a move
+in numbered or algebraic notation, such as 11-15, 11x18x25 or c3-d4.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 2 {
+				return nil
+			}
+			return cobra.ExactArgs(5)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argGameIndex := args[0]
+			if len(args) == 2 {
+				clientCtx, err := client.GetClientTxContext(cmd)
+				if err != nil {
+					return err
+				}
+				msg := types.NewMsgPlayMoveNotation(clientCtx.GetFromAddress().String(), argGameIndex, args[1])
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
 			argFromX, err := cast.ToUint64E(args[1])
 			if err != nil {
 				return err
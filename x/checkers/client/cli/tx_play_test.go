@@ -0,0 +1,73 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/LeTrongDat/checkers/testutil/network"
+	"github.com/LeTrongDat/checkers/x/checkers/client/cli"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+)
+
+// cancelWriter cancels the play session once the output contains stop.
+type cancelWriter struct {
+	bytes.Buffer
+	stop   string
+	cancel context.CancelFunc
+}
+
+func (w *cancelWriter) Write(p []byte) (int, error) {
+	n, err := w.Buffer.Write(p)
+	if strings.Contains(w.String(), w.stop) {
+		w.cancel()
+	}
+	return n, err
+}
+
+func TestPlayInteractively(t *testing.T) {
+	net := network.New(t, network.DefaultConfig())
+	val := net.Validators[0]
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(net.Config.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	args := append([]string{val.Address.String(), "cosmos16ur8ptycskmasf8jhuh6mlvrwq6ndwq47zqnar", "0"}, txFlags...)
+	_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdCreateGame(), args)
+	require.NoError(t, err)
+
+	// The session waits for red once black has played, so it is stopped there.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	play := &cancelWriter{stop: "Waiting for red to play", cancel: cancel}
+	clientCtx := val.ClientCtx.WithInput(strings.NewReader("hint\n11-16-20\n11-15\n"))
+	cmd := cli.CmdPlay()
+	cmd.SetArgs(append([]string{"1"}, txFlags...))
+	cmd.SetOut(play)
+	cmd.SetErr(play)
+	require.NoError(t, cmd.ExecuteContext(context.WithValue(ctx, client.ClientContextKey, &clientCtx)))
+	require.Contains(t, play.String(), " 8    b   b   b   b  8    1  2  3  4\n")
+	require.Contains(t, play.String(), "black: "+val.Address.String()+" (you, to move)")
+	require.Contains(t, play.String(), "Hint: ")
+	require.Contains(t, play.String(), "Not {black}'s turn\n")
+	require.Contains(t, play.String(), "red:  cosmos16ur8ptycskmasf8jhuh6mlvrwq6ndwq47zqnar (to move)")
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdShowStoredGame(), []string{"1", fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	require.NoError(t, err)
+	var res types.QueryGetStoredGameResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &res))
+	require.EqualValues(t, 1, res.StoredGame.MoveCount)
+	require.Equal(t, "*b*b*b*b|b*b*b*b*|*b*b***b|****b***|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", res.StoredGame.Board)
+}
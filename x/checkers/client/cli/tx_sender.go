@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/cobra"
+)
+
+const flagMaxRetries = "max-retries"
+
+func addMaxRetriesFlag(cmd *cobra.Command) {
+	cmd.Flags().Int(flagMaxRetries, 3, "Number of times a failed transaction is sent again")
+}
+
+// txSender signs and broadcasts transactions of the --from key one after the
+// other, for commands that send more than one.
+type txSender struct {
+	clientCtx  client.Context
+	txf        tx.Factory
+	maxRetries int
+}
+
+func newTxSender(clientCtx client.Context, txf tx.Factory, maxRetries int) *txSender {
+	return &txSender{
+		clientCtx:  clientCtx,
+		txf:        txf,
+		maxRetries: maxRetries,
+	}
+}
+
+// playMoveMsgs returns the messages that play all the steps of a move.
+func playMoveMsgs(player string, gameIndex string, steps []rules.Step) []sdk.Msg {
+	msgs := make([]sdk.Msg, 0, len(steps))
+	for _, step := range steps {
+		msgs = append(msgs, types.NewMsgPlayMove(player, gameIndex,
+			uint64(step.Src.X), uint64(step.Src.Y), uint64(step.Dst.X), uint64(step.Dst.Y)))
+	}
+	return msgs
+}
+
+// broadcast signs and sends the messages, keeping track of the sequence of
+// the account. On failure, it fetches the sequence again before retrying.
+func (s *txSender) broadcast(ctx context.Context, msgs []sdk.Msg) (res *sdk.TxResponse, err error) {
+	for attempt := 0; attempt <= s.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Duration(attempt) * time.Second):
+			}
+			s.txf = s.txf.WithAccountNumber(0).WithSequence(0)
+		}
+		if s.txf, err = s.txf.Prepare(s.clientCtx); err != nil {
+			continue
+		}
+		res, err = s.send(msgs)
+		if err != nil {
+			continue
+		}
+		if res.Code == 0 || res.Height > 0 {
+			// the transaction went into the mempool or a block, and used the sequence
+			s.txf = s.txf.WithSequence(s.txf.Sequence() + 1)
+		}
+		if res.Code == 0 {
+			return res, nil
+		}
+		err = errors.New(fmt.Sprintf("code %d: %s", res.Code, res.RawLog))
+	}
+	return nil, err
+}
+
+func (s *txSender) send(msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	txf := s.txf
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(s.clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+	builder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Sign(txf, s.clientCtx.GetFromName(), builder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return s.clientCtx.BroadcastTx(txBytes)
+}
+
+// waitIncluded waits for a broadcast transaction to be in a block, and returns
+// an error if it failed there.
+func (s *txSender) waitIncluded(ctx context.Context, res *sdk.TxResponse, poll time.Duration) error {
+	for res.Height == 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(poll):
+		}
+		if included, err := authtx.QueryTx(s.clientCtx, res.TxHash); err == nil {
+			res = included
+		}
+	}
+	if res.Code != 0 {
+		return errors.New(fmt.Sprintf("code %d: %s", res.Code, res.RawLog))
+	}
+	return nil
+}
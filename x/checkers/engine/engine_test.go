@@ -0,0 +1,170 @@
+package engine_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LeTrongDat/checkers/x/checkers/engine"
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func parse(t testing.TB, rows ...string) *rules.Game {
+	game, err := rules.Parse(strings.Join(rows, "|"))
+	require.Nil(t, err)
+	return game
+}
+
+func doubleJump(t testing.TB) *rules.Game {
+	return parse(t,
+		"*b******",
+		"**r*****",
+		"********",
+		"****r***",
+		"********",
+		"********",
+		"********",
+		"******r*")
+}
+
+func TestMovesOpening(t *testing.T) {
+	game := rules.New()
+	moves := engine.Moves(game)
+	require.Len(t, moves, len(game.ValidSteps()))
+	require.Equal(t, "1,2-0,3", moves[0].String())
+	require.Equal(t, "9-13", moves[0].Notation(rules.AMERICAN_VARIANT))
+}
+
+func TestMovesCaptureSequence(t *testing.T) {
+	moves := engine.Moves(doubleJump(t))
+	require.Len(t, moves, 1)
+	require.Equal(t, "1,0-3,2-5,4", moves[0].String())
+	require.Equal(t, "1x10x19", moves[0].Notation(rules.AMERICAN_VARIANT))
+	require.Len(t, moves[0].Steps, 2)
+}
+
+func TestMovePlay(t *testing.T) {
+	game := doubleJump(t)
+	require.Nil(t, engine.Moves(game)[0].Play(game))
+	require.Equal(t, "********|********|********|********|*****b**|********|********|******r*", game.String())
+	require.True(t, game.TurnIs(rules.RED_PLAYER))
+}
+
+func TestEvaluateOpeningIsEven(t *testing.T) {
+	require.Equal(t, 0, engine.Evaluate(rules.New()))
+	international, err := rules.NewGame(rules.INTERNATIONAL_VARIANT)
+	require.Nil(t, err)
+	require.Equal(t, 0, engine.Evaluate(international))
+}
+
+func TestSearchFindsWin(t *testing.T) {
+	game := parse(t,
+		"*b******",
+		"**r*****",
+		"********",
+		"********",
+		"********",
+		"********",
+		"********",
+		"********")
+	result, err := engine.Search(game, engine.Budget{Depth: 4})
+	require.Nil(t, err)
+	require.Equal(t, "1,0-3,2", result.Move.String())
+	require.Greater(t, result.Score, engine.WIN_SCORE-engine.MAX_DEPTH)
+}
+
+func TestSearchFindsBlockingWin(t *testing.T) {
+	// Moving to 2,5 leaves red without a move.
+	game := parse(t,
+		"********",
+		"********",
+		"********",
+		"********",
+		"***b****",
+		"********",
+		"*b******",
+		"r*******")
+	result, err := engine.Search(game, engine.Budget{Depth: 2})
+	require.Nil(t, err)
+	require.Equal(t, "3,4-2,5", result.Move.String())
+	require.Equal(t, engine.WIN_SCORE-1, result.Score)
+}
+
+func TestSearchAvoidsLosingMan(t *testing.T) {
+	// Moving to 5,4 gives the man away.
+	game := parse(t,
+		"********",
+		"********",
+		"********",
+		"****b***",
+		"********",
+		"******r*",
+		"*r******",
+		"******r*")
+	game.Turn = rules.BLACK_PLAYER
+	move, err := engine.BestMove(game, engine.Budget{Depth: 3})
+	require.Nil(t, err)
+	require.Equal(t, "4,3-3,4", move.String())
+}
+
+func TestSearchLeavesGameUntouched(t *testing.T) {
+	game := rules.New()
+	before := game.String()
+	hash := game.Zobrist()
+	_, err := engine.Search(game, engine.Budget{Depth: 5})
+	require.Nil(t, err)
+	require.Equal(t, before, game.String())
+	require.Equal(t, hash, game.Zobrist())
+}
+
+func TestSearchIsDeterministic(t *testing.T) {
+	first, err := engine.Search(rules.New(), engine.Budget{Depth: 6})
+	require.Nil(t, err)
+	second, err := engine.Search(rules.New(), engine.Budget{Depth: 6})
+	require.Nil(t, err)
+	require.Equal(t, first, second)
+	require.Equal(t, 6, first.Depth)
+}
+
+func TestSearchNoMove(t *testing.T) {
+	game := parse(t,
+		"********",
+		"********",
+		"********",
+		"********",
+		"********",
+		"********",
+		"********",
+		"******r*")
+	_, err := engine.Search(game, engine.Budget{Depth: 2})
+	require.Equal(t, engine.ErrNoMove, err)
+}
+
+func TestSearchTimeBudget(t *testing.T) {
+	start := time.Now()
+	result, err := engine.Search(rules.New(), engine.Budget{Time: 50 * time.Millisecond})
+	require.Nil(t, err)
+	require.Less(t, time.Since(start), time.Second)
+	require.GreaterOrEqual(t, result.Depth, 1)
+	require.Less(t, result.Depth, engine.MAX_DEPTH)
+}
+
+func TestSelfPlayIsLegal(t *testing.T) {
+	for _, variant := range []*rules.Variant{rules.AMERICAN_VARIANT, rules.INTERNATIONAL_VARIANT, rules.RUSSIAN_VARIANT} {
+		game, err := rules.NewGame(variant)
+		require.Nil(t, err)
+		for ply := 0; ply < 30 && game.Winner() == rules.NO_PLAYER; ply++ {
+			move, err := engine.BestMove(game, engine.Budget{Depth: 2})
+			require.Nil(t, err)
+			require.Nil(t, move.Play(game), variant.Name)
+			require.Equal(t, rules.NO_POS, game.Capturing)
+		}
+	}
+}
+
+func BenchmarkSearch(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		engine.Search(rules.New(), engine.Budget{Depth: 8})
+	}
+}
@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+)
+
+const (
+	MAN_VALUE         = 100
+	KING_VALUE        = 160
+	FLYING_KING_VALUE = 300
+	// ADVANCE_VALUE is earned by a man for every row it has advanced.
+	ADVANCE_VALUE = 4
+	// CENTER_VALUE is earned by a piece standing in the central columns.
+	CENTER_VALUE = 3
+	// BACK_ROW_VALUE is earned by a man guarding its own king row.
+	BACK_ROW_VALUE = 6
+)
+
+// Evaluate scores a position for the player whose turn it is, in hundredths
+// of a man. It does not look at whether the game is over.
+func Evaluate(game *rules.Game) int {
+	dim := game.Variant.BoardDim
+	kingValue := KING_VALUE
+	if game.Variant.FlyingKings {
+		kingValue = FLYING_KING_VALUE
+	}
+	score := 0
+	for pos, piece := range game.Pieces {
+		value := 0
+		if piece.King {
+			value += kingValue
+		} else {
+			advanced := pos.Y
+			if piece.Player == rules.RED_PLAYER {
+				advanced = dim - 1 - pos.Y
+			}
+			value += MAN_VALUE + advanced*ADVANCE_VALUE
+			if advanced == 0 {
+				value += BACK_ROW_VALUE
+			}
+		}
+		if dim/4 <= pos.X && pos.X < dim-dim/4 {
+			value += CENTER_VALUE
+		}
+		if piece.Player == game.Turn {
+			score += value
+		} else {
+			score -= value
+		}
+	}
+	return score
+}
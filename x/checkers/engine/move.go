@@ -0,0 +1,78 @@
+package engine
+
+import (
+	"strings"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+)
+
+// Move is a whole turn of a player: a single step, or all the steps of a
+// capture sequence.
+type Move struct {
+	Steps   []rules.Step
+	Capture bool
+}
+
+func (move Move) String() string {
+	if len(move.Steps) == 0 {
+		return ""
+	}
+	positions := []string{rules.FormatPos(move.Steps[0].Src)}
+	for _, step := range move.Steps {
+		positions = append(positions, rules.FormatPos(step.Dst))
+	}
+	return strings.Join(positions, "-")
+}
+
+// Notation writes the move in the numbered notation of the variant.
+func (move Move) Notation(variant *rules.Variant) string {
+	return variant.FormatMove(move.Steps, move.Capture)
+}
+
+// child is a position reached by a move
+type child struct {
+	move Move
+	game *rules.Game
+}
+
+// Moves lists the moves of the player whose turn it is, in a stable order.
+func Moves(game *rules.Game) []Move {
+	children := expand(game)
+	moves := make([]Move, 0, len(children))
+	for _, child := range children {
+		moves = append(moves, child.move)
+	}
+	return moves
+}
+
+func expand(game *rules.Game) []child {
+	children := []child{}
+	var extend func(game *rules.Game, steps []rules.Step)
+	extend = func(game *rules.Game, steps []rules.Step) {
+		for _, step := range game.ValidSteps() {
+			next := game.Copy()
+			captured, err := next.Move(step.Src, step.Dst)
+			if err != nil {
+				panic(err)
+			}
+			sequence := append(append([]rules.Step{}, steps...), step)
+			if next.Capturing != rules.NO_POS {
+				extend(next, sequence)
+			} else {
+				children = append(children, child{Move{sequence, captured != rules.NO_POS}, next})
+			}
+		}
+	}
+	extend(game, []rules.Step{})
+	return children
+}
+
+// Play applies all the steps of the move to the game.
+func (move Move) Play(game *rules.Game) error {
+	for _, step := range move.Steps {
+		if _, err := game.Move(step.Src, step.Dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
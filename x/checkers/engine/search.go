@@ -0,0 +1,238 @@
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+)
+
+const (
+	// WIN_SCORE is the score of a won position, less the plies it takes to win.
+	WIN_SCORE = 1000000
+	// MAX_DEPTH bounds searches that are only limited by time.
+	MAX_DEPTH     = 64
+	DEFAULT_DEPTH = 8
+	// CHECK_NODES is how many nodes are searched between two looks at the clock.
+	CHECK_NODES = 1024
+)
+
+var ErrNoMove = errors.New("no legal move")
+
+// Budget limits a search. A zero Depth means MAX_DEPTH when there is a Time
+// limit, and DEFAULT_DEPTH otherwise. A zero Time means no time limit.
+type Budget struct {
+	Depth int
+	Time  time.Duration
+}
+
+func (budget Budget) maxDepth() int {
+	switch {
+	case budget.Depth > 0:
+		return budget.Depth
+	case budget.Time > 0:
+		return MAX_DEPTH
+	default:
+		return DEFAULT_DEPTH
+	}
+}
+
+// Result is the outcome of the deepest completed iteration of a search.
+type Result struct {
+	Move Move
+	// Score is from the point of view of the player to move.
+	Score int
+	Depth int
+	Nodes uint64
+}
+
+type bound int
+
+const (
+	exact bound = iota
+	lower
+	upper
+)
+
+type entry struct {
+	depth int
+	score int
+	bound bound
+	best  int
+}
+
+type searcher struct {
+	deadline time.Time
+	nodes    uint64
+	aborted  bool
+	table    map[uint64]entry
+}
+
+// BestMove searches the game within the budget and returns the move to play.
+func BestMove(game *rules.Game, budget Budget) (Move, error) {
+	result, err := Search(game, budget)
+	return result.Move, err
+}
+
+// Search runs an iterative-deepening alpha-beta search of the game. The first
+// iteration always completes, so that a move is found however short the time.
+// The game is left untouched.
+func Search(game *rules.Game, budget Budget) (result Result, err error) {
+	children := expand(game)
+	if len(children) == 0 {
+		return result, ErrNoMove
+	}
+	var deadline time.Time
+	if budget.Time > 0 {
+		deadline = time.Now().Add(budget.Time)
+	}
+	s := &searcher{table: make(map[uint64]entry)}
+	best := 0
+	for depth := 1; depth <= budget.maxDepth(); depth++ {
+		index, score := s.root(game, children, best, depth)
+		if s.aborted {
+			break
+		}
+		s.deadline = deadline
+		best = index
+		result = Result{Move: children[best].move, Score: score, Depth: depth}
+		if len(children) == 1 || score >= WIN_SCORE-MAX_DEPTH || score <= -WIN_SCORE+MAX_DEPTH {
+			break
+		}
+	}
+	result.Nodes = s.nodes
+	return result, nil
+}
+
+func (s *searcher) root(game *rules.Game, children []child, best int, depth int) (int, int) {
+	alpha, beta := -WIN_SCORE-1, WIN_SCORE+1
+	bestIndex, bestScore := best, alpha
+	for _, i := range order(len(children), best) {
+		score := s.score(game, children[i].game, depth-1, 1, alpha, beta)
+		if s.aborted {
+			return bestIndex, bestScore
+		}
+		if score > bestScore {
+			bestIndex, bestScore = i, score
+		}
+		if score > alpha {
+			alpha = score
+		}
+	}
+	return bestIndex, bestScore
+}
+
+// score searches a child position and returns its score for the player who
+// moved into it, who keeps the turn and wins when the opponent cannot move.
+func (s *searcher) score(parent *rules.Game, game *rules.Game, depth, ply, alpha, beta int) int {
+	if game.Turn == parent.Turn {
+		return s.negamax(game, depth, ply, alpha, beta)
+	}
+	return -s.negamax(game, depth, ply, -beta, -alpha)
+}
+
+func (s *searcher) negamax(game *rules.Game, depth, ply, alpha, beta int) int {
+	s.nodes++
+	if !s.deadline.IsZero() && s.nodes%CHECK_NODES == 0 && time.Now().After(s.deadline) {
+		s.aborted = true
+	}
+	if s.aborted {
+		return 0
+	}
+	winner := game.Winner()
+	if winner == rules.NO_PLAYER {
+		winner = game.BlockedWinner()
+	}
+	if winner != rules.NO_PLAYER {
+		if winner == game.Turn {
+			return WIN_SCORE - ply
+		}
+		return -WIN_SCORE + ply
+	}
+	if depth <= 0 {
+		return Evaluate(game)
+	}
+
+	key := game.Zobrist()
+	best := -1
+	if stored, found := s.table[key]; found {
+		best = stored.best
+		if stored.depth >= depth {
+			score := fromTable(stored.score, ply)
+			switch {
+			case stored.bound == exact:
+				return score
+			case stored.bound == lower && score >= beta:
+				return score
+			case stored.bound == upper && score <= alpha:
+				return score
+			}
+		}
+	}
+
+	children := expand(game)
+	if len(children) == 0 {
+		return -WIN_SCORE + ply
+	}
+	original := alpha
+	bestIndex, bestScore := 0, -WIN_SCORE-1
+	for _, i := range order(len(children), best) {
+		score := s.score(game, children[i].game, depth-1, ply+1, alpha, beta)
+		if s.aborted {
+			return 0
+		}
+		if score > bestScore {
+			bestIndex, bestScore = i, score
+		}
+		if score > alpha {
+			alpha = score
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+
+	stored := entry{depth: depth, score: toTable(bestScore, ply), bound: exact, best: bestIndex}
+	if bestScore <= original {
+		stored.bound = upper
+	} else if bestScore >= beta {
+		stored.bound = lower
+	}
+	s.table[key] = stored
+	return bestScore
+}
+
+// order puts the best move found so far first.
+func order(count int, best int) []int {
+	indices := make([]int, 0, count)
+	if 0 <= best && best < count {
+		indices = append(indices, best)
+	}
+	for i := 0; i < count; i++ {
+		if i != best {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// Win scores are stored relative to the position, not to the root.
+func toTable(score int, ply int) int {
+	switch {
+	case score >= WIN_SCORE-MAX_DEPTH*2:
+		return score + ply
+	case score <= -WIN_SCORE+MAX_DEPTH*2:
+		return score - ply
+	}
+	return score
+}
+
+func fromTable(score int, ply int) int {
+	switch {
+	case score >= WIN_SCORE-MAX_DEPTH*2:
+		return score - ply
+	case score <= -WIN_SCORE+MAX_DEPTH*2:
+		return score + ply
+	}
+	return score
+}
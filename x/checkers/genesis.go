@@ -2,6 +2,7 @@ package checkers
 
 import (
 	"github.com/LeTrongDat/checkers/x/checkers/keeper"
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
 	"github.com/LeTrongDat/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -13,8 +14,19 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if genState.SystemInfo != nil {
 		k.SetSystemInfo(ctx, *genState.SystemInfo)
 	}
+	shift, err := genState.GetDeadlineShift(ctx.BlockTime())
+	if err != nil {
+		panic(err)
+	}
 	// Set all the storedGame
 	for _, elem := range genState.StoredGameList {
+		if shift != 0 && elem.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+			deadline, err := elem.GetDeadlineAsTime()
+			if err != nil {
+				panic(err)
+			}
+			elem.Deadline = types.FormatDeadline(deadline.Add(shift))
+		}
 		k.SetStoredGame(ctx, elem)
 	}
 	// this line is used by starport scaffolding # genesis/module/init
@@ -36,3 +48,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	return genesis
 }
+
+// ExportGenesisForZeroHeight exports as ExportGenesis does, and records the
+// block time so that the deadlines are rebased on the genesis time of the
+// restarted chain.
+func ExportGenesisForZeroHeight(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := ExportGenesis(ctx, k)
+	genesis.DeadlineBase = types.FormatDeadline(ctx.BlockTime())
+	return genesis
+}
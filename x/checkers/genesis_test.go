@@ -2,6 +2,7 @@ package checkers_test
 
 import (
 	"testing"
+	"time"
 
 	keepertest "github.com/LeTrongDat/checkers/testutil/keeper"
 	"github.com/LeTrongDat/checkers/testutil/nullify"
@@ -40,3 +41,52 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.StoredGameList, got.StoredGameList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisRebasesDeadlines(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params:       types.DefaultParams(),
+		DeadlineBase: "2022-06-01 10:00:00 +0000 UTC",
+		SystemInfo: &types.SystemInfo{
+			NextId:          3,
+			FifoHeadIndex:   "2",
+			FifoTailIndex:   "2",
+			InviteHeadIndex: "-1",
+			InviteTailIndex: "-1",
+		},
+		StoredGameList: []types.StoredGame{
+			{
+				Index:    "1",
+				Winner:   "b",
+				Deadline: "2022-06-01 09:00:00 +0000 UTC",
+			},
+			{
+				Index:    "2",
+				Winner:   "*",
+				Deadline: "2022-06-01 10:05:00 +0000 UTC",
+			},
+		},
+	}
+
+	k, ctx := keepertest.CheckersKeeper(t)
+	genesisTime, err := time.Parse(types.DeadlineLayout, "2022-06-03 00:00:00 +0000 UTC")
+	require.Nil(t, err)
+	checkers.InitGenesis(ctx.WithBlockTime(genesisTime), *k, genesisState)
+
+	game1, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "2022-06-01 09:00:00 +0000 UTC", game1.Deadline)
+	game2, found := k.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, "2022-06-03 00:05:00 +0000 UTC", game2.Deadline)
+}
+
+func TestExportGenesisForZeroHeight(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	blockTime, err := time.Parse(types.DeadlineLayout, "2022-06-01 10:00:00 +0000 UTC")
+	require.Nil(t, err)
+
+	got := checkers.ExportGenesisForZeroHeight(ctx.WithBlockTime(blockTime), *k)
+	require.Equal(t, "2022-06-01 10:00:00 +0000 UTC", got.DeadlineBase)
+	require.Empty(t, checkers.ExportGenesis(ctx, *k).DeadlineBase)
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ApplyMove plays a move the way a MsgPlayMove does, with the same checks,
+// queues, escrow, gas, events and hooks. The creator of the move has to be
+// the player whose turn it is. The move is checked against the board of the
+// game, which covers what ValidateBasic checks against the largest board. It
+// returns the position of the last capture, and the winner of the game if the
+// move ended it.
+func (k Keeper) ApplyMove(ctx sdk.Context, move *types.MsgPlayMove) (rules.Pos, string, error) {
+	storedGame, found := k.GetStoredGame(ctx, move.GameIndex)
+	if !found {
+		return rules.NO_POS, "", sdkerrors.Wrapf(types.ErrGameNotFound, "%s", move.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return rules.NO_POS, "", types.ErrGameFinished
+	}
+	if storedGame.IsPending() {
+		return rules.NO_POS, "", sdkerrors.Wrapf(types.ErrGamePending, "%s", move.GameIndex)
+	}
+	isBlack := move.Creator == storedGame.Black
+	isRed := move.Creator == storedGame.Red
+	var player rules.Player
+	if !isBlack && !isRed {
+		return rules.NO_POS, "", sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", move.Creator)
+	}
+	if isBlack && isRed {
+		// Only when the params allow self-play
+		player = rules.StringPieces[storedGame.Turn].Player
+	} else if isBlack {
+		player = rules.BLACK_PLAYER
+	} else {
+		player = rules.RED_PLAYER
+	}
+
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if !game.TurnIs(player) {
+		return rules.NO_POS, "", sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
+	}
+
+	steps, err := move.GetSteps(game.Variant)
+	if err != nil {
+		return rules.NO_POS, "", err
+	}
+	captures := make([]rules.Pos, 0, len(steps))
+	boards := make([]string, 0, len(steps))
+	turns := make([]rules.Player, 0, len(steps))
+	crowned := make([]bool, 0, len(steps))
+	for i, step := range steps {
+		if 0 < i && game.Capturing == rules.NO_POS {
+			return rules.NO_POS, "", sdkerrors.Wrapf(types.ErrWrongMove, "the turn ended before square %d", game.Variant.SquareNumber(step.Src))
+		}
+		wasKing := game.Pieces[step.Src].King
+		captured, moveErr := game.Move(step.Src, step.Dst)
+		if moveErr != nil {
+			return rules.NO_POS, "", sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
+		}
+		captures = append(captures, captured)
+		boards = append(boards, game.String())
+		turns = append(turns, game.Turn)
+		crowned = append(crowned, !wasKing && game.Pieces[step.Dst].King)
+	}
+	captured := captures[len(captures)-1]
+
+	winner, reason := game.Winner(), types.GameEndedReasonCaptureOut
+	if winner == rules.NO_PLAYER {
+		winner, reason = game.BlockedWinner(), types.GameEndedReasonBlocked
+	}
+	storedGame.Winner = rules.PieceStrings[winner]
+
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("System info not found")
+	}
+
+	err = k.CollectWager(ctx, &storedGame)
+	if err != nil {
+		return rules.NO_POS, "", err
+	}
+	lastBoard := game.String()
+	var blackPayout, redPayout uint64
+	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+		k.SendToFifoTail(ctx, &storedGame, &systemInfo)
+		storedGame.Board = lastBoard
+	} else {
+		k.RemoveFromFifo(ctx, &storedGame, &systemInfo)
+		blackPayout, redPayout = getPayouts(storedGame)
+		k.MustPayWinnings(ctx, &storedGame)
+		storedGame.Board = ""
+	}
+	moveCount := storedGame.MoveCount
+	storedGame.MoveCount += uint64(len(steps))
+	storedGame.Turn = rules.PieceStrings[game.Turn]
+	storedGame.Capturing = rules.FormatPos(game.Capturing)
+	storedGame.Captured = nil
+	for _, pos := range game.Captured {
+		storedGame.Captured = append(storedGame.Captured, rules.FormatPos(pos))
+	}
+	storedGame.Hash = game.Zobrist()
+	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
+
+	k.SetStoredGame(ctx, storedGame)
+	k.SetSystemInfo(ctx, systemInfo)
+
+	for i, step := range steps {
+		ctx.GasMeter().ConsumeGas(types.PlayMoveGas, "Play a move")
+
+		stepWinner := rules.PieceStrings[rules.NO_PLAYER]
+		if i == len(steps)-1 {
+			stepWinner = storedGame.Winner
+		}
+		moveCount++
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.MovePlayedEventType,
+				sdk.NewAttribute(types.MovePlayedEventCreator, move.Creator),
+				sdk.NewAttribute(types.MovePlayedEventGameIndex, storedGame.Index),
+				sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(captures[i].X), 10)),
+				sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(captures[i].Y), 10)),
+				sdk.NewAttribute(types.MovePlayedEventWinner, stepWinner),
+				sdk.NewAttribute(types.MovePlayedEventBoard, boards[i]),
+				sdk.NewAttribute(types.MovePlayedEventMove, game.Variant.FormatMove([]rules.Step{step}, captures[i] != rules.NO_POS)),
+				sdk.NewAttribute(types.MovePlayedEventFromX, strconv.Itoa(step.Src.X)),
+				sdk.NewAttribute(types.MovePlayedEventFromY, strconv.Itoa(step.Src.Y)),
+				sdk.NewAttribute(types.MovePlayedEventToX, strconv.Itoa(step.Dst.X)),
+				sdk.NewAttribute(types.MovePlayedEventToY, strconv.Itoa(step.Dst.Y)),
+				sdk.NewAttribute(types.MovePlayedEventCrowned, strconv.FormatBool(crowned[i])),
+				sdk.NewAttribute(types.MovePlayedEventNextTurn, rules.PieceStrings[turns[i]]),
+				sdk.NewAttribute(types.MovePlayedEventMoveCount, strconv.FormatUint(moveCount, 10)),
+				sdk.NewAttribute(types.MovePlayedEventDeadline, storedGame.Deadline),
+			),
+		)
+		err = ctx.EventManager().EmitTypedEvent(&types.EventMovePlayed{
+			Creator:   move.Creator,
+			GameIndex: storedGame.Index,
+			CapturedX: int32(captures[i].X),
+			CapturedY: int32(captures[i].Y),
+			Winner:    stepWinner,
+			Board:     boards[i],
+			Move:      game.Variant.FormatMove([]rules.Step{step}, captures[i] != rules.NO_POS),
+			FromX:     int32(step.Src.X),
+			FromY:     int32(step.Src.Y),
+			ToX:       int32(step.Dst.X),
+			ToY:       int32(step.Dst.Y),
+			Crowned:   crowned[i],
+			NextTurn:  rules.PieceStrings[turns[i]],
+			MoveCount: moveCount,
+			Deadline:  storedGame.Deadline,
+		})
+		if err != nil {
+			return rules.NO_POS, "", err
+		}
+	}
+	playerAddress, _, err := storedGame.GetPlayerAddress(rules.PieceStrings[player])
+	if err != nil {
+		return rules.NO_POS, "", err
+	}
+	k.AfterMovePlayed(ctx, storedGame, playerAddress)
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		if err := k.gameEnded(ctx, storedGame, reason, blackPayout, redPayout); err != nil {
+			return rules.NO_POS, "", err
+		}
+	}
+
+	return captured, storedGame.Winner, nil
+}
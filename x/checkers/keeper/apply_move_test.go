@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeperApplyMove(t *testing.T) {
+	_, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	ctx := sdk.UnwrapSDKContext(context)
+
+	captured, winner, err := keeper.ApplyMove(ctx, types.NewMsgPlayMoveNotation(bob, "1", "9-14"))
+	require.Nil(t, err)
+	require.EqualValues(t, rules.NO_POS, captured)
+	require.EqualValues(t, "*", winner)
+
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, 1, game1.MoveCount)
+	require.EqualValues(t, "r", game1.Turn)
+	require.EqualValues(t, 45, game1.BlackDeposit)
+}
+
+func TestKeeperApplyMoveNotPlayerTurn(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+
+	captured, winner, err := keeper.ApplyMove(ctx, types.NewMsgPlayMoveNotation(alice, "1", "21-17"))
+	require.EqualError(t, err, "{red}: player tried to play out of turn")
+	require.EqualValues(t, rules.NO_POS, captured)
+	require.EqualValues(t, "", winner)
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateGame creates a game the way a MsgCreateGame does, with the same
+// checks, queues, gas, events and hooks. Other modules can call it to start
+// games without a transaction of the creator.
+func (k Keeper) CreateGame(ctx sdk.Context, params types.CreateGameParams) (gameIndex string, err error) {
+	if err := params.Validate(); err != nil {
+		return "", err
+	}
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
+
+	if params.Black == params.Red && !k.AllowSelfPlay(ctx) {
+		return "", sdkerrors.Wrapf(types.ErrSelfPlay, "%s", params.Black)
+	}
+	if k.CreatorMustPlay(ctx) && params.Creator != params.Black && params.Creator != params.Red {
+		return "", sdkerrors.Wrapf(types.ErrCreatorNotInGame, "%s", params.Creator)
+	}
+
+	variant, err := rules.VariantByName(params.Variant)
+	if err != nil {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVariant, "%s: %s", params.Variant, err)
+	}
+	newGame, err := rules.NewGame(variant)
+	if err != nil {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVariant, "%s: %s", params.Variant, err)
+	}
+	storedGame := types.StoredGame{
+		Index:       newIndex,
+		Board:       newGame.String(),
+		Turn:        rules.PieceStrings[newGame.Turn],
+		Black:       params.Black,
+		Red:         params.Red,
+		BeforeIndex: types.NoFifoIndex,
+		AfterIndex:  types.NoFifoIndex,
+		MoveCount:   0,
+		Deadline:    types.FormatDeadline(types.GetNextDeadline(ctx)),
+		Winner:      rules.PieceStrings[rules.NO_PLAYER],
+		Wager:       params.Wager,
+		Variant:     variant.Name,
+		Hash:        newGame.Zobrist(),
+	}
+	if k.RequireAcceptance(ctx) {
+		storedGame.AwaitingBlack = params.Creator != params.Black
+		storedGame.AwaitingRed = params.Creator != params.Red
+	}
+	if err := storedGame.Validate(); err != nil {
+		return "", err
+	}
+	if storedGame.IsPending() {
+		// The clock starts when the players have accepted
+		storedGame.Deadline = types.FormatDeadline(types.GetInviteDeadline(ctx))
+		k.SendToInvitesTail(ctx, &storedGame, &systemInfo)
+	} else {
+		k.SendToFifoTail(ctx, &storedGame, &systemInfo)
+	}
+	k.SetStoredGame(ctx, storedGame)
+
+	systemInfo.NextId++
+	k.SetSystemInfo(ctx, systemInfo)
+
+	ctx.GasMeter().ConsumeGas(types.CreateGameGas, "Create Game")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameCreatedEventType,
+			sdk.NewAttribute(types.GameCreatedEventCreator, params.Creator),
+			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
+			sdk.NewAttribute(types.GameCreatedEventBlack, params.Black),
+			sdk.NewAttribute(types.GameCreatedEventRed, params.Red),
+			sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(params.Wager, 10)),
+			sdk.NewAttribute(types.GameCreatedEventVariant, variant.Name),
+		),
+	)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGameCreated{
+		Creator:   params.Creator,
+		GameIndex: newIndex,
+		Black:     params.Black,
+		Red:       params.Red,
+		Wager:     params.Wager,
+		Variant:   variant.Name,
+	})
+	if err != nil {
+		return "", err
+	}
+	k.AfterGameCreated(ctx, storedGame)
+
+	return newIndex, nil
+}
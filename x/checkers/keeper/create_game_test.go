@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeperCreateGame(t *testing.T) {
+	_, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	gameIndex, err := keeper.CreateGame(ctx, types.CreateGameParams{
+		Creator: alice,
+		Black:   bob,
+		Red:     alice,
+		Wager:   45,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, "1", gameIndex)
+
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, bob, game1.Black)
+	require.EqualValues(t, "american", game1.Variant)
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, "1", systemInfo.FifoHeadIndex)
+	require.EqualValues(t, 2, systemInfo.NextId)
+
+	events := legacyEvents(ctx)
+	require.Len(t, events, 1)
+	require.EqualValues(t, "new-game-created", events[0].Type)
+}
+
+func TestKeeperCreateGameBadParams(t *testing.T) {
+	_, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	gameIndex, err := keeper.CreateGame(ctx, types.CreateGameParams{
+		Creator: "notanaddress",
+		Black:   bob,
+		Red:     alice,
+	})
+	require.EqualValues(t, "", gameIndex)
+	require.EqualError(t, err, "invalid creator address (decoding bech32 failed: invalid separator index -1): invalid address")
+
+	_, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+}
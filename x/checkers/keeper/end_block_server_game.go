@@ -16,53 +16,95 @@ func (k Keeper) ForfeitExpiredGame(context context.Context) {
 		rules.PieceStrings[rules.BLACK_PLAYER]: rules.PieceStrings[rules.RED_PLAYER],
 		rules.PieceStrings[rules.RED_PLAYER]:   rules.PieceStrings[rules.BLACK_PLAYER],
 	}
-	systemInfo, found := k.GetSystemInfo(ctx)
-	if !found {
-		panic("SystemInfo not found")
+	for {
+		systemInfo, found := k.GetSystemInfo(ctx)
+		if !found {
+			panic("SystemInfo not found")
+		}
+		gameIndex := systemInfo.FifoHeadIndex
+		if gameIndex == types.NoFifoIndex {
+			break
+		}
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			panic("Fifo head game not found " + gameIndex)
+		}
+		deadline, err := storedGame.GetDeadlineAsTime()
+		if err != nil {
+			panic(err)
+		}
+		if !deadline.Before(ctx.BlockTime()) {
+			break
+		}
+		lastBoard := storedGame.Board
+		// red never played when there is no winner, so the game is cancelled
+		winner, reason := rules.PieceStrings[rules.NO_PLAYER], types.GameEndedReasonExpired
+		if storedGame.MoveCount > 1 {
+			winner, found = opponents[storedGame.Turn]
+			if !found {
+				panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Turn))
+			}
+			reason = types.GameEndedReasonForfeit
+		}
+		if err := k.EndGame(ctx, gameIndex, winner, reason); err != nil {
+			panic(err)
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.GameForfeitedEventType,
+				sdk.NewAttribute(types.GameForfeitedEventGameIndex, gameIndex),
+				sdk.NewAttribute(types.GameForfeitedEventWinner, winner),
+				sdk.NewAttribute(types.GameForfeitedEventBoard, lastBoard),
+			),
+		)
+		err = ctx.EventManager().EmitTypedEvent(&types.EventGameForfeited{
+			GameIndex: gameIndex,
+			Winner:    winner,
+			Board:     lastBoard,
+		})
+		if err != nil {
+			panic(err)
+		}
 	}
+}
+
+// ExpirePendingInvites cancels the games whose invitation was not accepted in
+// time. Nothing was escrowed for them since no move could be played.
+func (k Keeper) ExpirePendingInvites(context context.Context) {
+	ctx := sdk.UnwrapSDKContext(context)
 
-	gameIndex := systemInfo.FifoHeadIndex
-	var storedGame types.StoredGame
 	for {
+		systemInfo, found := k.GetSystemInfo(ctx)
+		if !found {
+			panic("SystemInfo not found")
+		}
+		gameIndex := systemInfo.InviteHeadIndex
 		if gameIndex == types.NoFifoIndex {
 			break
 		}
-		storedGame, found = k.GetStoredGame(ctx, gameIndex)
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
 		if !found {
-			panic("Fifo head game not found " + systemInfo.FifoHeadIndex)
+			panic("Invite head game not found " + gameIndex)
 		}
 		deadline, err := storedGame.GetDeadlineAsTime()
 		if err != nil {
 			panic(err)
 		}
-		if deadline.Before(ctx.BlockTime()) {
-			k.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-			lastBoard := storedGame.Board
-			if storedGame.MoveCount <= 1 {
-				k.RemoveStoredGame(ctx, storedGame.Index)
-				if storedGame.MoveCount == 1 {
-					k.MustRefundWager(ctx, &storedGame)
-				}
-			} else {
-				storedGame.Winner, found = opponents[storedGame.Turn]
-				if !found {
-					panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Turn))
-				}
-				storedGame.Board = ""
-				k.SetStoredGame(ctx, storedGame)
-				k.MustPayWinnings(ctx, &storedGame)
-			}
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(types.GameForfeitedEventType,
-					sdk.NewAttribute(types.GameForfeitedEventGameIndex, gameIndex),
-					sdk.NewAttribute(types.GameForfeitedEventWinner, storedGame.Winner),
-					sdk.NewAttribute(types.GameForfeitedEventBoard, lastBoard),
-				),
-			)
-			gameIndex = systemInfo.FifoHeadIndex
-		} else {
+		if !deadline.Before(ctx.BlockTime()) {
 			break
 		}
+		if err := k.EndGame(ctx, gameIndex, rules.PieceStrings[rules.NO_PLAYER], types.GameEndedReasonExpired); err != nil {
+			panic(err)
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.InviteExpiredEventType,
+				sdk.NewAttribute(types.InviteExpiredEventGameIndex, gameIndex),
+			),
+		)
+		err = ctx.EventManager().EmitTypedEvent(&types.EventInviteExpired{
+			GameIndex: gameIndex,
+		})
+		if err != nil {
+			panic(err)
+		}
 	}
-	k.SetSystemInfo(ctx, systemInfo)
 }
@@ -25,14 +25,26 @@ func TestForfeitUnplayed(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:          2,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo)
 
-	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	events := legacyEvents(ctx)
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "*"},
+			{Key: "reason", Value: "expired"},
+			{Key: "black-payout", Value: "0"},
+			{Key: "red-payout", Value: "0"},
+		},
+	}, events[0])
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -41,12 +53,25 @@ func TestForfeitUnplayed(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+
+	typed := typedEvents(t, ctx)
+	require.EqualValues(t, &types.EventGameForfeited{
+		GameIndex: "1",
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typed[len(typed)-1])
+	require.EqualValues(t, &types.EventGameEnded{
+		GameIndex: "1",
+		Winner:    "*",
+		Reason:    "expired",
+	}, typed[len(typed)-2])
 }
 
 func TestForfeitPlayedOnce(t *testing.T) {
 	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	defer ctrl.Finish()
-	escrow.ExpectAny(context)
+	pay := escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, bob, 45).Times(1).After(pay)
 	ctx := sdk.UnwrapSDKContext(context)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
@@ -62,23 +87,32 @@ func TestForfeitPlayedOnce(t *testing.T) {
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGame(context)
 
-	// pay := escrow.ExpectPay(context, bob, 45).Times(1)
-	// escrow.ExpectRefund(context, bob, 45).Times(1).After(pay)
-
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:          2,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo)
 
-	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	events := legacyEvents(ctx)
+	require.Len(t, events, 4)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "*"},
+			{Key: "reason", Value: "expired"},
+			{Key: "black-payout", Value: "45"},
+			{Key: "red-payout", Value: "0"},
+		},
+	}, events[0])
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -131,19 +165,33 @@ func TestForfeitPlayedTwice(t *testing.T) {
 		Deadline:    oldDeadline,
 		Winner:      "r",
 		Wager:       45,
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	}, game1)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:          2,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo)
 
-	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	events := legacyEvents(ctx)
+	require.Len(t, events, 4)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "r"},
+			{Key: "reason", Value: "forfeit"},
+			{Key: "black-payout", Value: "0"},
+			{Key: "red-payout", Value: "90"},
+		},
+	}, events[0])
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EndGame ends an ongoing game from outside of it, for instance when a
+// tournament or a governance proposal adjudicates it. With a winner, the game
+// is kept and the winner gets the escrow. With NO_PLAYER as the winner, the
+// game is cancelled, deleted and the deposits are refunded. The queues, events
+// and hooks are the same as when a game ends by itself.
+func (k Keeper) EndGame(ctx sdk.Context, gameIndex string, winner string, reason string) error {
+	switch reason {
+	case types.GameEndedReasonCaptureOut, types.GameEndedReasonBlocked, types.GameEndedReasonForfeit,
+		types.GameEndedReasonResign, types.GameEndedReasonDraw, types.GameEndedReasonRejected,
+		types.GameEndedReasonExpired:
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidEndReason, "%s", reason)
+	}
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return types.ErrGameFinished
+	}
+	cancelled := winner == rules.PieceStrings[rules.NO_PLAYER]
+	if !cancelled {
+		if winner != rules.PieceStrings[rules.BLACK_PLAYER] && winner != rules.PieceStrings[rules.RED_PLAYER] {
+			return sdkerrors.Wrapf(types.ErrInvalidWinner, "%s", winner)
+		}
+		if storedGame.IsPending() {
+			return sdkerrors.Wrapf(types.ErrGamePending, "%s", gameIndex)
+		}
+	}
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+
+	k.RemoveFromQueue(ctx, &storedGame, &systemInfo)
+	var blackPayout, redPayout uint64
+	if cancelled {
+		k.RemoveStoredGame(ctx, storedGame.Index)
+		blackPayout, redPayout = getPayouts(storedGame)
+		k.MustRefundWager(ctx, &storedGame)
+	} else {
+		storedGame.Winner = winner
+		storedGame.Board = ""
+		blackPayout, redPayout = getPayouts(storedGame)
+		// Nothing is escrowed yet when black has not played
+		if storedGame.GetEscrowed() != 0 || storedGame.Wager == 0 {
+			k.MustPayWinnings(ctx, &storedGame)
+		}
+		k.SetStoredGame(ctx, storedGame)
+	}
+	k.SetSystemInfo(ctx, systemInfo)
+
+	return k.gameEnded(ctx, storedGame, reason, blackPayout, redPayout)
+}
+
+// getPayouts tells what each player gets back from the escrow when the game
+// ends now. The winner takes all the deposits, and without a winner each
+// player gets its own deposit refunded.
+func getPayouts(storedGame types.StoredGame) (blackPayout uint64, redPayout uint64) {
+	switch storedGame.Winner {
+	case rules.PieceStrings[rules.BLACK_PLAYER]:
+		return storedGame.GetEscrowed(), 0
+	case rules.PieceStrings[rules.RED_PLAYER]:
+		return 0, storedGame.GetEscrowed()
+	}
+	return storedGame.BlackDeposit, storedGame.RedDeposit
+}
+
+// gameEnded emits the game-ended events and calls the hooks, once the game is
+// saved and the escrow paid out.
+func (k Keeper) gameEnded(ctx sdk.Context, storedGame types.StoredGame, reason string, blackPayout uint64, redPayout uint64) error {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameEndedEventType,
+			sdk.NewAttribute(types.GameEndedEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.GameEndedEventWinner, storedGame.Winner),
+			sdk.NewAttribute(types.GameEndedEventReason, reason),
+			sdk.NewAttribute(types.GameEndedEventBlackPayout, strconv.FormatUint(blackPayout, 10)),
+			sdk.NewAttribute(types.GameEndedEventRedPayout, strconv.FormatUint(redPayout, 10)),
+		),
+	)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventGameEnded{
+		GameIndex:   storedGame.Index,
+		Winner:      storedGame.Winner,
+		Reason:      reason,
+		BlackPayout: blackPayout,
+		RedPayout:   redPayout,
+	})
+	if err != nil {
+		return err
+	}
+
+	var winner, loser sdk.AccAddress
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		winner, _, err = storedGame.GetWinnerAddress()
+		if err != nil {
+			return err
+		}
+		loserColor := rules.PieceStrings[rules.Opponents[rules.StringPieces[storedGame.Winner].Player]]
+		loser, _, err = storedGame.GetPlayerAddress(loserColor)
+		if err != nil {
+			return err
+		}
+	}
+	k.AfterGameEnded(ctx, storedGame, winner, loser, reason)
+	return nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndGameWinner(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
+	payAlice := escrow.ExpectPay(context, alice, 45).Times(1).After(payBob)
+	escrow.ExpectRefund(context, alice, 90).Times(1).After(payAlice)
+	ctx := sdk.UnwrapSDKContext(context)
+	playAllMoves(t, msgServer, context, "1", game1Moves[:2])
+
+	err := keeper.EndGame(ctx, "1", "r", types.GameEndedReasonResign)
+	require.Nil(t, err)
+
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, "r", game1.Winner)
+	require.EqualValues(t, "", game1.Board)
+	require.EqualValues(t, 0, game1.GetEscrowed())
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, "-1", systemInfo.FifoHeadIndex)
+	require.EqualValues(t, "-1", systemInfo.FifoTailIndex)
+
+	typed := typedEvents(t, ctx)
+	require.EqualValues(t, &types.EventGameEnded{
+		GameIndex: "1",
+		Winner:    "r",
+		Reason:    "resign",
+		RedPayout: 90,
+	}, typed[len(typed)-1])
+}
+
+func TestEndGameWinnerBeforeBlackPlayed(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+
+	err := keeper.EndGame(ctx, "1", "b", types.GameEndedReasonResign)
+	require.Nil(t, err)
+
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, "b", game1.Winner)
+}
+
+func TestEndGameCancelled(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
+	payAlice := escrow.ExpectPay(context, alice, 45).Times(1).After(payBob)
+	escrow.ExpectRefund(context, bob, 45).Times(1).After(payAlice)
+	escrow.ExpectRefund(context, alice, 45).Times(1).After(payAlice)
+	ctx := sdk.UnwrapSDKContext(context)
+	playAllMoves(t, msgServer, context, "1", game1Moves[:2])
+
+	err := keeper.EndGame(ctx, "1", "*", types.GameEndedReasonDraw)
+	require.Nil(t, err)
+
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, "-1", systemInfo.FifoHeadIndex)
+
+	events := legacyEvents(ctx)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "*"},
+			{Key: "reason", Value: "draw"},
+			{Key: "black-payout", Value: "45"},
+			{Key: "red-payout", Value: "45"},
+		},
+	}, events[0])
+}
+
+func TestEndGameInvalidReason(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	err := keeper.EndGame(sdk.UnwrapSDKContext(context), "1", "b", "bored")
+	require.EqualError(t, err, "bored: reason to end the game is invalid")
+}
+
+func TestEndGameInvalidWinner(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	err := keeper.EndGame(sdk.UnwrapSDKContext(context), "1", "B", types.GameEndedReasonResign)
+	require.EqualError(t, err, "B: winner is invalid")
+}
+
+func TestEndGameNotFound(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	err := keeper.EndGame(sdk.UnwrapSDKContext(context), "2", "b", types.GameEndedReasonResign)
+	require.EqualError(t, err, "2: game by id not found")
+}
+
+func TestEndGameAlreadyFinished(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+	require.Nil(t, keeper.EndGame(ctx, "1", "b", types.GameEndedReasonResign))
+
+	err := keeper.EndGame(ctx, "1", "r", types.GameEndedReasonResign)
+	require.EqualError(t, err, types.ErrGameFinished.Error())
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Implements CheckersHooks interface
+var _ types.CheckersHooks = Keeper{}
+
+// AfterGameCreated - call hook if registered
+func (k Keeper) AfterGameCreated(ctx sdk.Context, storedGame types.StoredGame) {
+	if k.hooks != nil {
+		k.hooks.AfterGameCreated(ctx, storedGame)
+	}
+}
+
+// AfterMovePlayed - call hook if registered
+func (k Keeper) AfterMovePlayed(ctx sdk.Context, storedGame types.StoredGame, player sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterMovePlayed(ctx, storedGame, player)
+	}
+}
+
+// AfterGameEnded - call hook if registered
+func (k Keeper) AfterGameEnded(ctx sdk.Context, storedGame types.StoredGame, winner sdk.AccAddress, loser sdk.AccAddress, reason string) {
+	if k.hooks != nil {
+		k.hooks.AfterGameEnded(ctx, storedGame, winner, loser, reason)
+	}
+}
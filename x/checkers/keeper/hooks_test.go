@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	keepertest "github.com/LeTrongDat/checkers/testutil/keeper"
+	"github.com/LeTrongDat/checkers/testutil/mock_types"
+	"github.com/LeTrongDat/checkers/x/checkers"
+	"github.com/LeTrongDat/checkers/x/checkers/keeper"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithHooks(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context, *gomock.Controller, *mock_types.MockCheckersHooks) {
+	ctrl := gomock.NewController(t)
+	bankMock := mock_types.NewMockBankEscrowKeeper(ctrl)
+	hooksMock := mock_types.NewMockCheckersHooks(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMock(t, bankMock)
+	k.SetHooks(hooksMock)
+
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	return keeper.NewMsgServerImpl(*k), *k, context, ctrl, hooksMock
+}
+
+func createGameWithHooks(t testing.TB, msgServer types.MsgServer, context context.Context, hooks *mock_types.MockCheckersHooks) {
+	hooks.EXPECT().AfterGameCreated(sdk.UnwrapSDKContext(context), gomock.Any()).Times(1)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     alice,
+		Wager:   45,
+	})
+	require.Nil(t, err)
+}
+
+func TestSetHooksTwicePanics(t *testing.T) {
+	k, _ := keepertest.CheckersKeeper(t)
+	k.SetHooks(types.NewMultiCheckersHooks())
+	require.PanicsWithValue(t, "cannot set checkers hooks twice", func() {
+		k.SetHooks(types.NewMultiCheckersHooks())
+	})
+}
+
+func TestHooksAfterGameCreated(t *testing.T) {
+	msgServer, _, context, ctrl, hooks := setupMsgServerWithHooks(t)
+	defer ctrl.Finish()
+	hooks.EXPECT().
+		AfterGameCreated(sdk.UnwrapSDKContext(context), gomock.Any()).
+		Do(func(ctx sdk.Context, storedGame types.StoredGame) {
+			require.EqualValues(t, "1", storedGame.Index)
+			require.EqualValues(t, bob, storedGame.Black)
+			require.EqualValues(t, alice, storedGame.Red)
+		}).
+		Times(1)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     alice,
+		Wager:   45,
+	})
+	require.Nil(t, err)
+}
+
+func TestHooksAfterMovePlayed(t *testing.T) {
+	msgServer, _, context, ctrl, hooks := setupMsgServerWithHooks(t)
+	defer ctrl.Finish()
+	createGameWithHooks(t, msgServer, context, hooks)
+	hooks.EXPECT().
+		AfterMovePlayed(sdk.UnwrapSDKContext(context), gomock.Any(), sdk.MustAccAddressFromBech32(bob)).
+		Do(func(ctx sdk.Context, storedGame types.StoredGame, player sdk.AccAddress) {
+			require.EqualValues(t, 1, storedGame.MoveCount)
+			require.EqualValues(t, "r", storedGame.Turn)
+		}).
+		Times(1)
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+}
+
+func TestHooksAfterGameEndedWinner(t *testing.T) {
+	msgServer, _, context, ctrl, hooks := setupMsgServerWithHooks(t)
+	defer ctrl.Finish()
+	createGameWithHooks(t, msgServer, context, hooks)
+	played := hooks.EXPECT().AfterMovePlayed(sdk.UnwrapSDKContext(context), gomock.Any(), gomock.Any()).
+		Times(len(game1Moves))
+	hooks.EXPECT().
+		AfterGameEnded(sdk.UnwrapSDKContext(context), gomock.Any(),
+			sdk.MustAccAddressFromBech32(bob), sdk.MustAccAddressFromBech32(alice), "capture-out").
+		Do(func(ctx sdk.Context, storedGame types.StoredGame, winner sdk.AccAddress, loser sdk.AccAddress, reason string) {
+			require.EqualValues(t, "b", storedGame.Winner)
+		}).
+		Times(1).
+		After(played)
+
+	playAllMoves(t, msgServer, context, "1", game1Moves)
+}
+
+func TestHooksAfterGameEndedForfeitUnplayed(t *testing.T) {
+	msgServer, keeper, context, ctrl, hooks := setupMsgServerWithHooks(t)
+	defer ctrl.Finish()
+	createGameWithHooks(t, msgServer, context, hooks)
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game1)
+	hooks.EXPECT().
+		AfterGameEnded(ctx, gomock.Any(), sdk.AccAddress(nil), sdk.AccAddress(nil), "expired").
+		Times(1)
+
+	keeper.ForfeitExpiredGame(context)
+}
+
+func TestHooksAfterGameEndedForfeitPlayedTwice(t *testing.T) {
+	msgServer, keeper, context, ctrl, hooks := setupMsgServerWithHooks(t)
+	defer ctrl.Finish()
+	createGameWithHooks(t, msgServer, context, hooks)
+	hooks.EXPECT().AfterMovePlayed(sdk.UnwrapSDKContext(context), gomock.Any(), gomock.Any()).Times(2)
+	playAllMoves(t, msgServer, context, "1", game1Moves[:2])
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game1)
+	hooks.EXPECT().
+		AfterGameEnded(ctx, gomock.Any(), sdk.MustAccAddressFromBech32(alice), sdk.MustAccAddressFromBech32(bob), "forfeit").
+		Times(1)
+
+	keeper.ForfeitExpiredGame(context)
+}
+
+func TestHooksAfterGameEndedRejected(t *testing.T) {
+	msgServer, _, context, ctrl, hooks := setupMsgServerWithHooks(t)
+	defer ctrl.Finish()
+	createGameWithHooks(t, msgServer, context, hooks)
+	hooks.EXPECT().
+		AfterGameEnded(sdk.UnwrapSDKContext(context), gomock.Any(), sdk.AccAddress(nil), sdk.AccAddress(nil), "rejected").
+		Do(func(ctx sdk.Context, storedGame types.StoredGame, winner sdk.AccAddress, loser sdk.AccAddress, reason string) {
+			require.EqualValues(t, "1", storedGame.Index)
+			require.EqualValues(t, "*", storedGame.Winner)
+		}).
+		Times(1)
+
+	_, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+}
+
+func TestHooksAfterGameEndedInviteExpired(t *testing.T) {
+	msgServer, keeper, context, ctrl, hooks := setupMsgServerWithHooks(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+	keeper.SetParams(ctx, types.NewParams(false, false, true))
+	createGameWithHooks(t, msgServer, context, hooks)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.True(t, game1.IsPending())
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.MaxInviteDuration + time.Second))
+	hooks.EXPECT().
+		AfterGameEnded(ctx, gomock.Any(), sdk.AccAddress(nil), sdk.AccAddress(nil), "expired").
+		Do(func(ctx sdk.Context, storedGame types.StoredGame, winner sdk.AccAddress, loser sdk.AccAddress, reason string) {
+			require.EqualValues(t, "1", storedGame.Index)
+		}).
+		Times(1)
+
+	keeper.ExpirePendingInvites(sdk.WrapSDKContext(ctx))
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	EscrowBalanceInvariantName  = "escrow-balance"
+	FinishedEscrowInvariantName = "finished-games-escrow"
+	FifoInvariantName           = "fifo-consistency"
+)
+
+// RegisterInvariants registers the checkers module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, EscrowBalanceInvariantName, EscrowBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, FinishedEscrowInvariantName, FinishedEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, FifoInvariantName, FifoInvariant(k))
+}
+
+// AllInvariants runs all invariants of the checkers module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := EscrowBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = FinishedEscrowInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return FifoInvariant(k)(ctx)
+	}
+}
+
+// EscrowBalanceInvariant checks that the module account holds exactly the
+// deposits of the games that are not finished.
+func EscrowBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		escrowed := uint64(0)
+		for _, storedGame := range k.GetAllStoredGame(ctx) {
+			if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+				escrowed += storedGame.GetEscrowed()
+			}
+		}
+		expected := types.GetStakeCoin(escrowed)
+		balance := k.bank.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), expected.Denom)
+		broken := !balance.IsEqual(expected)
+		return sdk.FormatInvariant(types.ModuleName, EscrowBalanceInvariantName, fmt.Sprintf(
+			"\tmodule account balance: %s\n\tdeposits of unfinished games: %s\n", balance, expected)), broken
+	}
+}
+
+// FinishedEscrowInvariant checks that the deposits of finished games have all
+// been paid out.
+func FinishedEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		holding := []string{}
+		for _, storedGame := range k.GetAllStoredGame(ctx) {
+			if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] && storedGame.GetEscrowed() != 0 {
+				holding = append(holding, fmt.Sprintf("\tgame %s: %s\n", storedGame.Index, storedGame.GetEscrowedCoin()))
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, FinishedEscrowInvariantName, fmt.Sprintf(
+			"finished games still holding deposits: %d\n%s", len(holding), strings.Join(holding, ""))), len(holding) != 0
+	}
+}
+
+// FifoInvariant checks that the deadline FIFO and the list of pending
+// invitations are well linked, hold exactly the games they should, and are
+// sorted by deadline.
+func FifoInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		systemInfo, found := k.GetSystemInfo(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, FifoInvariantName, "system info not found\n"), true
+		}
+		games := map[string]types.StoredGame{}
+		for _, storedGame := range k.GetAllStoredGame(ctx) {
+			games[storedGame.Index] = storedGame
+		}
+		problems := types.FifoProblems(systemInfo, games)
+		return sdk.FormatInvariant(types.ModuleName, FifoInvariantName, fmt.Sprintf(
+			"problems found: %d\n%s", len(problems), formatProblems(problems))), len(problems) != 0
+	}
+}
+
+func formatProblems(problems []string) string {
+	var builder strings.Builder
+	for _, problem := range problems {
+		builder.WriteString("\t" + problem + "\n")
+	}
+	return builder.String()
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/LeTrongDat/checkers/testutil/keeper"
+	"github.com/LeTrongDat/checkers/x/checkers/keeper"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscrowBalanceInvariantHolds(t *testing.T) {
+	k, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	k.SetStoredGame(ctx, types.StoredGame{Index: "1", Winner: "*", Wager: 45, BlackDeposit: 45, RedDeposit: 45,
+		Deadline: "2022-06-01 10:00:00 +0000 UTC", BeforeIndex: "-1", AfterIndex: "2"})
+	k.SetStoredGame(ctx, types.StoredGame{Index: "2", Winner: "*", Wager: 10, BlackDeposit: 10,
+		Deadline: "2022-06-01 10:00:00 +0000 UTC", BeforeIndex: "1", AfterIndex: "-1"})
+	k.SetStoredGame(ctx, types.StoredGame{Index: "3", Winner: "b", Wager: 20, BeforeIndex: "-1", AfterIndex: "-1"})
+	k.SetSystemInfo(ctx, types.SystemInfo{
+		NextId:          4,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "2",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
+	})
+	escrow.EXPECT().
+		GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), sdk.DefaultBondDenom).
+		Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestEscrowBalanceInvariantBroken(t *testing.T) {
+	k, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	k.SetStoredGame(ctx, types.StoredGame{Index: "1", Winner: "*", Wager: 45, BlackDeposit: 45})
+	escrow.EXPECT().
+		GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), sdk.DefaultBondDenom).
+		Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 90))
+
+	msg, broken := keeper.EscrowBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "module account balance: 90stake\n")
+	require.Contains(t, msg, "deposits of unfinished games: 45stake\n")
+}
+
+func TestFinishedEscrowInvariantBroken(t *testing.T) {
+	k, context, ctrl, _ := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	k.SetStoredGame(ctx, types.StoredGame{Index: "1", Winner: "r", Wager: 45, BlackDeposit: 45, RedDeposit: 45})
+	k.SetStoredGame(ctx, types.StoredGame{Index: "2", Winner: "b", Wager: 45})
+
+	msg, broken := keeper.FinishedEscrowInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "finished games still holding deposits: 1\n\tgame 1: 90stake\n")
+}
+
+func TestFifoInvariantHoldsAfterCreate(t *testing.T) {
+	msgServer, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	for i := 0; i < 3; i++ {
+		_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+			Creator: alice,
+			Black:   alice,
+			Red:     bob,
+		})
+		require.Nil(t, err)
+	}
+
+	msg, broken := keeper.FifoInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestFifoInvariantBroken(t *testing.T) {
+	msgServer, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	for i := 0; i < 2; i++ {
+		_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+			Creator: alice,
+			Black:   alice,
+			Red:     bob,
+		})
+		require.Nil(t, err)
+	}
+	game2, found := k.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	game2.BeforeIndex = "-1"
+	k.SetStoredGame(ctx, game2)
+
+	msg, broken := keeper.FifoInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "problems found: 1\n\tfifo: game 2 links back to -1 instead of 1\n")
+}
+
+func TestFifoInvariantNoSystemInfo(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	msg, broken := keeper.FifoInvariant(*k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "system info not found\n")
+}
@@ -18,6 +18,7 @@ type (
 		memKey     sdk.StoreKey
 		paramstore paramtypes.Subspace
 		bank       types.BankEscrowKeeper
+		hooks      types.CheckersHooks
 	}
 )
 
@@ -42,6 +43,18 @@ func NewKeeper(
 	}
 }
 
+// SetHooks sets the checkers hooks. It has to be called before the keeper is
+// handed to the module, since the module keeps a copy of it.
+func (k *Keeper) SetHooks(ch types.CheckersHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set checkers hooks twice")
+	}
+
+	k.hooks = ch
+
+	return k
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
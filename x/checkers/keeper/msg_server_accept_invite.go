@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AcceptInvite(goCtx context.Context, msg *types.MsgAcceptInvite) (*types.MsgAcceptInviteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+
+	isBlack := msg.Creator == storedGame.Black
+	isRed := msg.Creator == storedGame.Red
+	if !isBlack && !isRed {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+	if !(isBlack && storedGame.AwaitingBlack) && !(isRed && storedGame.AwaitingRed) {
+		return nil, sdkerrors.Wrapf(types.ErrNothingToAccept, "%s", msg.Creator)
+	}
+	if isBlack {
+		storedGame.AwaitingBlack = false
+	}
+	if isRed {
+		storedGame.AwaitingRed = false
+	}
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("System info not found")
+	}
+	started := !storedGame.IsPending()
+	if started {
+		// Both players have consented, so black's clock starts now
+		k.Keeper.RemoveFromInvites(ctx, &storedGame, &systemInfo)
+		storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
+		k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
+	}
+	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.InviteAcceptedEventType,
+			sdk.NewAttribute(types.InviteAcceptedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.InviteAcceptedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.InviteAcceptedEventStarted, strconv.FormatBool(started)),
+		),
+	)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventInviteAccepted{
+		Creator:   msg.Creator,
+		GameIndex: msg.GameIndex,
+		Started:   started,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptInviteResponse{
+		Started: started,
+	}, nil
+}
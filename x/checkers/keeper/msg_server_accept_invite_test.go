@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	keepertest "github.com/LeTrongDat/checkers/testutil/keeper"
+	"github.com/LeTrongDat/checkers/testutil/mock_types"
+	"github.com/LeTrongDat/checkers/x/checkers"
+	"github.com/LeTrongDat/checkers/x/checkers/keeper"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneInvite(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context, *gomock.Controller, *mock_types.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := mock_types.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMock(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	k.SetParams(ctx, types.NewParams(false, false, true))
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	server.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     alice,
+		Wager:   45,
+	})
+	return server, *k, context, ctrl, bankMock
+}
+
+func TestCreateGamePendingSaved(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneInvite(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:          2,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "1",
+		InviteTailIndex: "1",
+	}, systemInfo)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.True(t, game1.AwaitingBlack)
+	require.False(t, game1.AwaitingRed)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(types.MaxInviteDuration)), game1.Deadline)
+}
+
+func TestPlayMovePendingGame(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneInvite(t)
+	defer ctrl.Finish()
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		Move:      "11-15",
+	})
+	require.Nil(t, playMoveResponse)
+	require.ErrorIs(t, err, types.ErrGamePending)
+	require.EqualError(t, err, "1: game is waiting for its players to accept")
+}
+
+func TestAcceptInviteStartsGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneInvite(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context).WithBlockTime(time.Unix(1_000, 0))
+	context = sdk.WrapSDKContext(ctx)
+	acceptInviteResponse, err := msgServer.AcceptInvite(context, &types.MsgAcceptInvite{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptInviteResponse{Started: true}, *acceptInviteResponse)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:          2,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
+	}, systemInfo)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.False(t, game1.IsPending())
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)), game1.Deadline)
+
+	events := legacyEvents(ctx)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "invite-accepted",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "started", Value: "true"},
+		},
+	}, events[0])
+
+	typed := typedEvents(t, ctx)
+	require.EqualValues(t, &types.EventInviteAccepted{
+		Creator:   bob,
+		GameIndex: "1",
+		Started:   true,
+	}, typed[len(typed)-1])
+}
+
+func TestAcceptInviteByBothInvitedPlayers(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneInvite(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+	})
+	acceptInviteResponse, err := msgServer.AcceptInvite(context, &types.MsgAcceptInvite{
+		Creator:   carol,
+		GameIndex: "2",
+	})
+	require.Nil(t, err)
+	require.False(t, acceptInviteResponse.Started)
+	game2, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.True(t, game2.AwaitingBlack)
+	require.False(t, game2.AwaitingRed)
+
+	acceptInviteResponse, err = msgServer.AcceptInvite(context, &types.MsgAcceptInvite{
+		Creator:   bob,
+		GameIndex: "2",
+	})
+	require.Nil(t, err)
+	require.True(t, acceptInviteResponse.Started)
+
+	// Game 1 is still pending, alone in the invitations
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:          3,
+		FifoHeadIndex:   "2",
+		FifoTailIndex:   "2",
+		InviteHeadIndex: "1",
+		InviteTailIndex: "1",
+	}, systemInfo)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.NoFifoIndex, game1.BeforeIndex)
+	require.Equal(t, types.NoFifoIndex, game1.AfterIndex)
+}
+
+func TestAcceptInviteNothingToAccept(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneInvite(t)
+	defer ctrl.Finish()
+	acceptInviteResponse, err := msgServer.AcceptInvite(context, &types.MsgAcceptInvite{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptInviteResponse)
+	require.ErrorIs(t, err, types.ErrNothingToAccept)
+
+	_, err = msgServer.AcceptInvite(context, &types.MsgAcceptInvite{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+
+	_, err = msgServer.AcceptInvite(context, &types.MsgAcceptInvite{
+		Creator:   bob,
+		GameIndex: "2",
+	})
+	require.ErrorIs(t, err, types.ErrGameNotFound)
+}
+
+func TestRejectPendingGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneInvite(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+	_, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.Equal(t, types.NoFifoIndex, systemInfo.InviteHeadIndex)
+	require.Equal(t, types.NoFifoIndex, systemInfo.InviteTailIndex)
+}
+
+func TestExpirePendingInvites(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneInvite(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   alice,
+		Red:     carol,
+	})
+
+	// Not yet expired
+	keeper.ExpirePendingInvites(context)
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.MaxInviteDuration + time.Second))
+	keeper.ExpirePendingInvites(sdk.WrapSDKContext(ctx))
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	_, found = keeper.GetStoredGame(ctx, "2")
+	require.False(t, found)
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:          3,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
+	}, systemInfo)
+
+	events := legacyEvents(ctx)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "*"},
+			{Key: "reason", Value: "expired"},
+			{Key: "black-payout", Value: "0"},
+			{Key: "red-payout", Value: "0"},
+			{Key: "game-index", Value: "2"},
+			{Key: "winner", Value: "*"},
+			{Key: "reason", Value: "expired"},
+			{Key: "black-payout", Value: "0"},
+			{Key: "red-payout", Value: "0"},
+		},
+	}, events[0])
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "invite-expired",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "game-index", Value: "2"},
+		},
+	}, events[1])
+
+	typed := typedEvents(t, ctx)
+	require.EqualValues(t, []proto.Message{
+		&types.EventGameEnded{GameIndex: "1", Winner: "*", Reason: "expired"},
+		&types.EventInviteExpired{GameIndex: "1"},
+		&types.EventGameEnded{GameIndex: "2", Winner: "*", Reason: "expired"},
+		&types.EventInviteExpired{GameIndex: "2"},
+	}, typed[len(typed)-4:])
+}
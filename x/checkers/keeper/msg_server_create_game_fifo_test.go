@@ -25,9 +25,11 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 	systemInfo2, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "2",
+		NextId:          3,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "2",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo2)
 
 	game1, found := keeper.GetStoredGame(ctx, "1")
@@ -43,6 +45,8 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 		AfterIndex:  "2",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	}, game1)
 
 	game2, found := keeper.GetStoredGame(ctx, "2")
@@ -58,6 +62,8 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 		AfterIndex:  "-1",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	}, game2)
 
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
@@ -68,9 +74,11 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 	systemInfo3, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "3",
+		NextId:          4,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "3",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo3)
 
 	game1, found = keeper.GetStoredGame(ctx, "1")
@@ -86,6 +94,8 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 		AfterIndex:  "2",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	}, game1)
 
 	game2, found = keeper.GetStoredGame(ctx, "2")
@@ -101,6 +111,8 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 		AfterIndex:  "3",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	}, game2)
 
 	game3, found := keeper.GetStoredGame(ctx, "3")
@@ -116,5 +128,7 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 		AfterIndex:  "-1",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	}, game3)
 }
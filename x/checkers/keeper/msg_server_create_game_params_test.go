@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGameSelfPlayRejected(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	createGameResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   alice,
+		Red:     alice,
+	})
+	require.Nil(t, createGameResponse)
+	require.ErrorIs(t, err, types.ErrSelfPlay)
+	require.EqualError(t, err, alice+": black and red are the same player")
+	_, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, found)
+}
+
+func TestCreateGameSelfPlayAllowed(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	keeper.SetParams(ctx, types.NewParams(true, false, false))
+	createGameResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   alice,
+		Red:     alice,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "1", createGameResponse.GameIndex)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, alice, game.Black)
+	require.Equal(t, alice, game.Red)
+}
+
+func TestCreateGameCreatorMustPlay(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	keeper.SetParams(sdk.UnwrapSDKContext(context), types.NewParams(false, true, false))
+	createGameResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: carol,
+		Black:   alice,
+		Red:     bob,
+	})
+	require.Nil(t, createGameResponse)
+	require.ErrorIs(t, err, types.ErrCreatorNotInGame)
+	require.EqualError(t, err, carol+": game creator plays neither black nor red")
+
+	createGameResponse, err = msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   alice,
+		Red:     bob,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "1", createGameResponse.GameIndex)
+}
+
+func TestCreateGameByThirdPartyByDefault(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	createGameResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: carol,
+		Black:   alice,
+		Red:     bob,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "1", createGameResponse.GameIndex)
+}
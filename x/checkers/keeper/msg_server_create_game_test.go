@@ -7,8 +7,10 @@ import (
 	keepertest "github.com/LeTrongDat/checkers/testutil/keeper"
 	"github.com/LeTrongDat/checkers/x/checkers"
 	"github.com/LeTrongDat/checkers/x/checkers/keeper"
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
 	"github.com/LeTrongDat/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +26,13 @@ func setupMsgServerCreateGame(t testing.TB) (types.MsgServer, keeper.Keeper, con
 	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx)
 }
 
+func boardHash(t testing.TB, board string, turn string) uint64 {
+	game, err := rules.Parse(board)
+	require.Nil(t, err)
+	game.Turn = rules.StringPieces[turn].Player
+	return game.Zobrist()
+}
+
 func TestCreateGame(t *testing.T) {
 	msgServer, _, context := setupMsgServerCreateGame(t)
 	createGameResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
@@ -48,9 +57,11 @@ func TestCreateGameHasSaved(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, systemInfo, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:          2,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	})
 
 	game1, found1 := keeper.GetStoredGame(ctx, "1")
@@ -65,6 +76,8 @@ func TestCreateGameHasSaved(t *testing.T) {
 		AfterIndex:  "-1",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	})
 }
 
@@ -88,6 +101,8 @@ func TestCreateGameGetAll(t *testing.T) {
 		AfterIndex:  "-1",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	})
 }
 
@@ -99,7 +114,8 @@ func TestCreateGameRedAddressBad(t *testing.T) {
 		Red:     "notanaddress",
 	})
 	require.Nil(t, createResponse)
-	require.EqualError(t, err, "red address is invalid: notanaddress: decoding bech32 failed: invalid separator index -1")
+	require.ErrorIs(t, err, types.ErrInvalidRed)
+	require.EqualError(t, err, "notanaddress: decoding bech32 failed: invalid separator index -1: red address is invalid")
 }
 
 func TestCreateGameEmptyRedAddress(t *testing.T) {
@@ -110,7 +126,53 @@ func TestCreateGameEmptyRedAddress(t *testing.T) {
 		Red:     "",
 	})
 	require.Nil(t, createResponse)
-	require.EqualError(t, err, "red address is invalid: : empty address string is not allowed")
+	require.ErrorIs(t, err, types.ErrInvalidRed)
+	require.EqualError(t, err, ": empty address string is not allowed: red address is invalid")
+}
+
+func TestCreateGameUnknownVariant(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   alice,
+		Red:     bob,
+		Variant: "chinese",
+	})
+	require.Nil(t, createResponse)
+	require.ErrorIs(t, err, types.ErrInvalidVariant)
+	require.EqualError(t, err, "chinese: unknown variant: chinese: variant is invalid")
+}
+
+func TestCreateGameExplicitVariant(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   alice,
+		Red:     bob,
+		Variant: "american",
+	})
+	require.Nil(t, err)
+	game1, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), createResponse.GameIndex)
+	require.True(t, found)
+	require.EqualValues(t, "american", game1.Variant)
+}
+
+func TestCreateGameInternational(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   alice,
+		Red:     bob,
+		Variant: "international",
+	})
+	require.Nil(t, err)
+	game1, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), createResponse.GameIndex)
+	require.True(t, found)
+	require.EqualValues(t, "international", game1.Variant)
+	require.EqualValues(t,
+		"*b*b*b*b*b|b*b*b*b*b*|*b*b*b*b*b|b*b*b*b*b*|**********|**********|*r*r*r*r*r|r*r*r*r*r*|*r*r*r*r*r|r*r*r*r*r*",
+		game1.Board)
+	require.EqualValues(t, "", game1.Capturing)
 }
 
 func TestCreate3Games(t *testing.T) {
@@ -135,9 +197,11 @@ func TestCreate3Games(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, systemInfo, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "3",
+		NextId:          4,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "3",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	})
 
 	games := keeper.GetAllStoredGame(ctx)
@@ -153,6 +217,8 @@ func TestCreate3Games(t *testing.T) {
 		AfterIndex:  "2",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	})
 
 	require.EqualValues(t, games[1], types.StoredGame{
@@ -165,6 +231,8 @@ func TestCreate3Games(t *testing.T) {
 		AfterIndex:  "3",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	})
 
 	require.EqualValues(t, games[2], types.StoredGame{
@@ -177,6 +245,8 @@ func TestCreate3Games(t *testing.T) {
 		AfterIndex:  "-1",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	})
 }
 
@@ -199,9 +269,11 @@ func TestCreateGameFarFuture(t *testing.T) {
 	systemInfo, found = keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, systemInfo, types.SystemInfo{
-		NextId:        1025,
-		FifoHeadIndex: "1024",
-		FifoTailIndex: "1024",
+		NextId:          1025,
+		FifoHeadIndex:   "1024",
+		FifoTailIndex:   "1024",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	})
 
 	game, found := keeper.GetStoredGame(ctx, "1024")
@@ -216,6 +288,8 @@ func TestCreateGameFarFuture(t *testing.T) {
 		AfterIndex:  "-1",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	})
 
 }
@@ -229,7 +303,7 @@ func TestCreateGameEmitted(t *testing.T) {
 	})
 	ctx := sdk.UnwrapSDKContext(context)
 	require.NotNil(t, ctx)
-	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	events := legacyEvents(ctx)
 	require.Len(t, events, 1)
 	event := events[0]
 	require.EqualValues(t, sdk.StringEvent{
@@ -240,8 +314,19 @@ func TestCreateGameEmitted(t *testing.T) {
 			{Key: "black", Value: alice},
 			{Key: "red", Value: bob},
 			{Key: "wager", Value: "0"},
+			{Key: "variant", Value: "american"},
 		},
 	}, event)
+
+	require.EqualValues(t, []proto.Message{
+		&types.EventGameCreated{
+			Creator:   alice,
+			GameIndex: "1",
+			Black:     alice,
+			Red:       bob,
+			Variant:   "american",
+		},
+	}, typedEvents(t, ctx))
 }
 
 func TestCreate1GameConsumedGas(t *testing.T) {
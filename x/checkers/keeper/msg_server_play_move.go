@@ -2,105 +2,22 @@ package keeper
 
 import (
 	"context"
-	"strconv"
 
-	"github.com/LeTrongDat/checkers/x/checkers/rules"
 	"github.com/LeTrongDat/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*types.MsgPlayMoveResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO: Handling the message
-	_ = ctx
-	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
-	}
-	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
-		return nil, types.ErrGameFinished
-	}
-	isBlack := msg.Creator == storedGame.Black
-	isRed := msg.Creator == storedGame.Red
-	var player rules.Player
-	if !isBlack && !isRed {
-		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
-	}
-	if isBlack && isRed {
-		player = rules.StringPieces[storedGame.Turn].Player
-	} else if isBlack {
-		player = rules.BLACK_PLAYER
-	} else {
-		player = rules.RED_PLAYER
-	}
-
-	game, err := storedGame.ParseGame()
-	if err != nil {
-		panic(err.Error())
-	}
-
-	if !game.TurnIs(player) {
-		return nil, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
-	}
-
-	captured, moveErr := game.Move(
-		rules.Pos{
-			X: int(msg.FromX),
-			Y: int(msg.FromY),
-		},
-		rules.Pos{
-			X: int(msg.ToX),
-			Y: int(msg.ToY),
-		})
-	if moveErr != nil {
-		return nil, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
-	}
-
-	storedGame.Winner = rules.PieceStrings[game.Winner()]
-
-	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
-	if !found {
-		panic("System info not found")
-	}
-
-	lastBoard := game.String()
-	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
-		k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
-		storedGame.Board = lastBoard
-	} else {
-		k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-		k.Keeper.MustPayWinnings(ctx, &storedGame)
-		storedGame.Board = ""
-	}
-	err = k.Keeper.CollectWager(ctx, &storedGame)
+	captured, winner, err := k.Keeper.ApplyMove(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
-	storedGame.MoveCount++
-	storedGame.Turn = rules.PieceStrings[game.Turn]
-	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
-
-	k.Keeper.SetStoredGame(ctx, storedGame)
-	k.Keeper.SetSystemInfo(ctx, systemInfo)
-
-	ctx.GasMeter().ConsumeGas(types.PlayMoveGas, "Play a move")
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(types.MovePlayedEventType,
-			sdk.NewAttribute(types.MovePlayedEventCreator, msg.Creator),
-			sdk.NewAttribute(types.MovePlayedEventGameIndex, storedGame.Index),
-			sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(captured.X), 10)),
-			sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(captured.Y), 10)),
-			sdk.NewAttribute(types.MovePlayedEventWinner, rules.PieceStrings[game.Winner()]),
-			sdk.NewAttribute(types.MovePlayedEventBoard, lastBoard),
-		),
-	)
 
 	return &types.MsgPlayMoveResponse{
 		CapturedX: int32(captured.X),
 		CapturedY: int32(captured.Y),
-		Winner:    rules.PieceStrings[game.Winner()],
+		Winner:    winner,
 	}, nil
 }
@@ -39,25 +39,30 @@ func TestPlayMove2Games2MovesHasSavedFifo(t *testing.T) {
 	systemInfo1, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "2",
+		NextId:          3,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "2",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo1)
 
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         alice,
-		MoveCount:   1,
-		BeforeIndex: "-1",
-		AfterIndex:  "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "r",
+		Black:        bob,
+		Red:          alice,
+		MoveCount:    1,
+		BeforeIndex:  "-1",
+		AfterIndex:   "2",
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Variant:      "american",
+		Hash:         boardHash(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "r"),
+		BlackDeposit: 45,
 	}, game1)
 
 	game2, found := keeper.GetStoredGame(ctx, "2")
@@ -73,5 +78,7 @@ func TestPlayMove2Games2MovesHasSavedFifo(t *testing.T) {
 		AfterIndex:  "-1",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "r"),
 	}, game2)
 }
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlayMoveNotation(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playMoveResponse, err := msgServer.PlayMove(context, types.NewMsgPlayMoveNotation(bob, "1", "9-14"))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMoveResponse{
+		CapturedX: -1,
+		CapturedY: -1,
+		Winner:    "*",
+	}, *playMoveResponse)
+	game1, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.True(t, found)
+	require.EqualValues(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", game1.Board)
+	require.EqualValues(t, 1, game1.MoveCount)
+}
+
+func TestPlayMoveAlgebraicNotation(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	_, err := msgServer.PlayMove(context, types.NewMsgPlayMoveNotation(bob, "1", "b6-c5"))
+	require.Nil(t, err)
+	game1, _ := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.EqualValues(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", game1.Board)
+}
+
+func TestPlayMoveNotationCaptureSequence(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	game1.Board = "*b******|**r*****|********|****r***|********|********|********|******r*"
+	keeper.SetStoredGame(ctx, game1)
+
+	playMoveResponse, err := msgServer.PlayMove(context, types.NewMsgPlayMoveNotation(bob, "1", "1x10x19"))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMoveResponse{
+		CapturedX: 4,
+		CapturedY: 3,
+		Winner:    "*",
+	}, *playMoveResponse)
+	game1, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "********|********|********|********|*****b**|********|********|******r*", game1.Board)
+	require.EqualValues(t, 2, game1.MoveCount)
+	require.EqualValues(t, "r", game1.Turn)
+	require.EqualValues(t, "", game1.Capturing)
+
+	events := legacyEvents(ctx)
+	event := events[0]
+	require.EqualValues(t, "move-played", event.Type)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "creator", Value: bob},
+		{Key: "game-index", Value: "1"},
+		{Key: "capture-x", Value: "2"},
+		{Key: "capture-y", Value: "1"},
+		{Key: "winner", Value: "*"},
+		{Key: "board", Value: "********|**r*****|***b****|****r***|********|********|********|******r*"},
+		{Key: "move", Value: "1x10"},
+		{Key: "from-x", Value: "1"},
+		{Key: "from-y", Value: "0"},
+		{Key: "to-x", Value: "3"},
+		{Key: "to-y", Value: "2"},
+		{Key: "crowned", Value: "false"},
+		{Key: "next-turn", Value: "b"},
+		{Key: "move-count", Value: "1"},
+		{Key: "deadline", Value: game1.Deadline},
+		{Key: "creator", Value: bob},
+		{Key: "game-index", Value: "1"},
+		{Key: "capture-x", Value: "4"},
+		{Key: "capture-y", Value: "3"},
+		{Key: "winner", Value: "*"},
+		{Key: "board", Value: "********|********|********|********|*****b**|********|********|******r*"},
+		{Key: "move", Value: "10x19"},
+		{Key: "from-x", Value: "3"},
+		{Key: "from-y", Value: "2"},
+		{Key: "to-x", Value: "5"},
+		{Key: "to-y", Value: "4"},
+		{Key: "crowned", Value: "false"},
+		{Key: "next-turn", Value: "r"},
+		{Key: "move-count", Value: "2"},
+		{Key: "deadline", Value: game1.Deadline},
+	}, event.Attributes)
+}
+
+func TestPlayMoveNotationAfterTurnEnded(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	playMoveResponse, err := msgServer.PlayMove(context, types.NewMsgPlayMoveNotation(bob, "1", "9-14-18"))
+	require.Nil(t, playMoveResponse)
+	require.EqualError(t, err, "the turn ended before square 14: wrong move")
+	game1, _ := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.EqualValues(t, 0, game1.MoveCount)
+}
+
+func TestPlayMoveNotationCaptureSequenceSplit(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	game1.Board = "*b******|**r*****|********|****r***|********|********|********|******r*"
+	keeper.SetStoredGame(ctx, game1)
+
+	_, err := msgServer.PlayMove(context, types.NewMsgPlayMoveNotation(bob, "1", "1x10"))
+	require.Nil(t, err)
+	game1, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "********|**r*****|***b****|****r***|********|********|********|******r*", game1.Board)
+	require.EqualValues(t, "3,2", game1.Capturing)
+	require.EqualValues(t, []string{"2,1"}, game1.Captured)
+
+	_, err = msgServer.PlayMove(context, types.NewMsgPlayMoveNotation(bob, "1", "10x19"))
+	require.Nil(t, err)
+	game1, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "********|********|********|********|*****b**|********|********|******r*", game1.Board)
+	require.EqualValues(t, "", game1.Capturing)
+	require.Empty(t, game1.Captured)
+	require.EqualValues(t, "r", game1.Turn)
+}
+
+func TestPlayMoveNotationOutOfBoard(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	playMoveResponse, err := msgServer.PlayMove(context, types.NewMsgPlayMoveNotation(bob, "1", "12-33"))
+	require.Nil(t, playMoveResponse)
+	require.ErrorIs(t, err, types.ErrInvalidMoveNotation)
+	require.EqualError(t, err, "12-33: square out of the board: 33: move notation is invalid")
+}
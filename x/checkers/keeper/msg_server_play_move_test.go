@@ -67,9 +67,10 @@ func TestPlayMoveGameNotFound(t *testing.T) {
 }
 
 func TestPlayMoveSameBlackRed(t *testing.T) {
-	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	defer ctrl.Finish()
 	escrow.ExpectAny(context)
+	keeper.SetParams(sdk.UnwrapSDKContext(context), types.NewParams(true, false, false))
 	msgServer.CreateGame(context, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
@@ -107,25 +108,30 @@ func TestPlayMoveSavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, systemInfo, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:          2,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	})
 
 	game, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, game, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         alice,
-		MoveCount:   1,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "r",
+		Black:        bob,
+		Red:          alice,
+		MoveCount:    1,
+		BeforeIndex:  "-1",
+		AfterIndex:   "-1",
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Variant:      "american",
+		Hash:         boardHash(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "r"),
+		BlackDeposit: 45,
 	})
 }
 
@@ -174,6 +180,21 @@ func TestPlayMoveWrongMove(t *testing.T) {
 	require.EqualError(t, err, "Already piece at destination position: {0 1}: wrong move")
 }
 
+func TestPlayMoveOutOfBoard(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1000,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, playMoveResponse)
+	require.EqualError(t, err, "position out of the 8x8 board: wrong move")
+}
+
 func TestPlayMoveCannotParseGame(t *testing.T) {
 	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
 	defer ctrl.Finish()
@@ -250,25 +271,31 @@ func TestPlayMove2SavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:          2,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo)
 
 	game, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Black:       bob,
-		Red:         alice,
-		Turn:        "b",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
-		MoveCount:   2,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
+		Index:        "1",
+		Black:        bob,
+		Red:          alice,
+		Turn:         "b",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		MoveCount:    2,
+		BeforeIndex:  "-1",
+		AfterIndex:   "-1",
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Variant:      "american",
+		Hash:         boardHash(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
+		BlackDeposit: 45,
+		RedDeposit:   45,
 	}, game)
 }
 
@@ -340,25 +367,31 @@ func TestPlayMove3SavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:          2,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo)
 
 	game, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|********|********|b*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Black:       bob,
-		Red:         alice,
-		Turn:        "r",
-		MoveCount:   3,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|********|********|b*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Black:        bob,
+		Red:          alice,
+		Turn:         "r",
+		MoveCount:    3,
+		BeforeIndex:  "-1",
+		AfterIndex:   "-1",
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Variant:      "american",
+		Hash:         boardHash(t, "*b*b*b*b|b*b*b*b*|***b*b*b|********|********|b*r*r*r*|*r*r*r*r|r*r*r*r*", "r"),
+		BlackDeposit: 45,
+		RedDeposit:   45,
 	}, game)
 }
 
@@ -378,7 +411,7 @@ func TestPlayMoveEmitted(t *testing.T) {
 		ToY:       3,
 	})
 
-	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	events := legacyEvents(ctx)
 	require.Len(t, events, 2)
 
 	event := events[0]
@@ -391,9 +424,35 @@ func TestPlayMoveEmitted(t *testing.T) {
 			{Key: "capture-y", Value: "-1"},
 			{Key: "winner", Value: "*"},
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+			{Key: "move", Value: "9-14"},
+			{Key: "from-x", Value: "1"},
+			{Key: "from-y", Value: "2"},
+			{Key: "to-x", Value: "2"},
+			{Key: "to-y", Value: "3"},
+			{Key: "crowned", Value: "false"},
+			{Key: "next-turn", Value: "r"},
+			{Key: "move-count", Value: "1"},
+			{Key: "deadline", Value: types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration))},
 		},
 	}, event)
 
+	typed := typedEvents(t, ctx)
+	require.EqualValues(t, &types.EventMovePlayed{
+		Creator:   bob,
+		GameIndex: "1",
+		CapturedX: -1,
+		CapturedY: -1,
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Move:      "9-14",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+		NextTurn:  "r",
+		MoveCount: 1,
+		Deadline:  types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+	}, typed[len(typed)-1])
 }
 
 func TestPlayMove2Emitted(t *testing.T) {
@@ -419,7 +478,7 @@ func TestPlayMove2Emitted(t *testing.T) {
 		ToX:       1,
 		ToY:       4,
 	})
-	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	events := legacyEvents(ctx)
 	require.Len(t, events, 2)
 	event := events[0]
 	require.EqualValues(t, "move-played", event.Type)
@@ -430,6 +489,15 @@ func TestPlayMove2Emitted(t *testing.T) {
 		{Key: "capture-y", Value: "-1"},
 		{Key: "winner", Value: "*"},
 		{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
-	}, event.Attributes[6:])
+		{Key: "move", Value: "21-17"},
+		{Key: "from-x", Value: "0"},
+		{Key: "from-y", Value: "5"},
+		{Key: "to-x", Value: "1"},
+		{Key: "to-y", Value: "4"},
+		{Key: "crowned", Value: "false"},
+		{Key: "next-turn", Value: "b"},
+		{Key: "move-count", Value: "2"},
+		{Key: "deadline", Value: types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration))},
+	}, event.Attributes[15:])
 
 }
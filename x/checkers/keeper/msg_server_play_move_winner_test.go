@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/LeTrongDat/checkers/x/checkers/types"
@@ -95,9 +96,11 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:          2,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo)
 
 	game, found := keeper.GetStoredGame(ctx, "1")
@@ -114,10 +117,22 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "b",
 		Wager:       45,
+		Variant:     "american",
+		Hash:        0x149772d5e0d5752c,
 	}, game)
-	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	events := legacyEvents(ctx)
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "b"},
+			{Key: "reason", Value: "capture-out"},
+			{Key: "black-payout", Value: "90"},
+			{Key: "red-payout", Value: "0"},
+		},
+	}, events[0])
+	event := events[1]
 	require.Equal(t, event.Type, "move-played")
 	require.EqualValues(t, []sdk.Attribute{
 		{Key: "creator", Value: bob},
@@ -126,6 +141,91 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 		{Key: "capture-y", Value: "5"},
 		{Key: "winner", Value: "b"},
 		{Key: "board", Value: "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********"},
-	}, event.Attributes[(len(game1Moves)-1)*6:])
+		{Key: "move", Value: "25x18"},
+		{Key: "from-x", Value: "1"},
+		{Key: "from-y", Value: "6"},
+		{Key: "to-x", Value: "3"},
+		{Key: "to-y", Value: "4"},
+		{Key: "crowned", Value: "false"},
+		{Key: "next-turn", Value: "b"},
+		{Key: "move-count", Value: strconv.Itoa(len(game1Moves))},
+		{Key: "deadline", Value: game.Deadline},
+	}, event.Attributes[(len(game1Moves)-1)*15:])
 
 }
+
+func TestPlayMoveBlockedWinner(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	game1.Board = "********|********|********|********|***b****|********|*b******|r*******"
+	keeper.SetStoredGame(ctx, game1)
+
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     3,
+		FromY:     4,
+		ToX:       2,
+		ToY:       5,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMoveResponse{
+		CapturedX: -1,
+		CapturedY: -1,
+		Winner:    "b",
+	}, *playMoveResponse)
+	game1, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "b", game1.Winner)
+	require.EqualValues(t, "", game1.Board)
+
+	events := legacyEvents(ctx)
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "b"},
+			{Key: "reason", Value: "blocked"},
+			{Key: "black-payout", Value: "45"},
+			{Key: "red-payout", Value: "0"},
+		},
+	}, events[0])
+
+	typed := typedEvents(t, ctx)
+	require.EqualValues(t, &types.EventGameEnded{
+		GameIndex:   "1",
+		Winner:      "b",
+		Reason:      "blocked",
+		BlackPayout: 45,
+	}, typed[len(typed)-1])
+}
+
+func TestPlayMoveCrownedEmitted(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	game1.Board = "********|********|********|********|***b****|********|*b******|r*******"
+	keeper.SetStoredGame(ctx, game1)
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     6,
+		ToX:       2,
+		ToY:       7,
+	})
+	require.Nil(t, err)
+
+	typed := typedEvents(t, ctx)
+	movePlayed, ok := typed[len(typed)-1].(*types.EventMovePlayed)
+	require.True(t, ok)
+	require.True(t, movePlayed.Crowned)
+	require.EqualValues(t, "r", movePlayed.NextTurn)
+	require.EqualValues(t, "*", movePlayed.Winner)
+}
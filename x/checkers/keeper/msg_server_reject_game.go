@@ -34,15 +34,17 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 	} else {
 		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
 	}
-	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
-	if !found {
-		panic("System info was not found")
+	err := k.Keeper.EndGame(ctx, msg.GameIndex, rules.PieceStrings[rules.NO_PLAYER], types.GameEndedReasonRejected)
+	if err != nil {
+		return nil, err
 	}
 
-	k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-	k.Keeper.RemoveStoredGame(ctx, storedGame.Index)
-	k.Keeper.MustRefundWager(ctx, &storedGame)
-	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	// Deleting the game frees storage, which is rewarded
+	refund := uint64(types.RejectGameRefundGas)
+	if consumed := ctx.GasMeter().GasConsumed(); consumed < refund {
+		refund = consumed
+	}
+	ctx.GasMeter().RefundGas(refund, "Reject game")
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.GameRejectedEventType,
@@ -50,6 +52,13 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 			sdk.NewAttribute(types.GameRejectedEventGameIndex, msg.GameIndex),
 		),
 	)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGameRejected{
+		Creator:   msg.Creator,
+		GameIndex: msg.GameIndex,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.MsgRejectGameResponse{}, nil
 }
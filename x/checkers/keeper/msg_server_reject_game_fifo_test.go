@@ -29,9 +29,11 @@ func TestRejectMiddleGameHasSavedFifo(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "3",
+		NextId:          4,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "3",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo)
 
 	game1, found := keeper.GetStoredGame(ctx, "1")
@@ -47,6 +49,8 @@ func TestRejectMiddleGameHasSavedFifo(t *testing.T) {
 		AfterIndex:  "3",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	}, game1)
 
 	game3, found := keeper.GetStoredGame(ctx, "3")
@@ -62,5 +66,7 @@ func TestRejectMiddleGameHasSavedFifo(t *testing.T) {
 		AfterIndex:  "-1",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
+		Variant:     "american",
+		Hash:        boardHash(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", "b"),
 	}, game3)
 }
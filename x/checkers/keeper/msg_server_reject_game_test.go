@@ -62,9 +62,11 @@ func TestRejectGameByBlackNoMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:          2,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
@@ -79,9 +81,19 @@ func TestRejectGameByBlackNoMoveEmitted(t *testing.T) {
 		GameIndex: "1",
 	})
 	require.NotNil(t, ctx)
-	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	events := legacyEvents(ctx)
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "*"},
+			{Key: "reason", Value: "rejected"},
+			{Key: "black-payout", Value: "0"},
+			{Key: "red-payout", Value: "0"},
+		},
+	}, events[0])
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -89,6 +101,12 @@ func TestRejectGameByBlackNoMoveEmitted(t *testing.T) {
 			{Key: "game-index", Value: "1"},
 		},
 	}, event)
+
+	typed := typedEvents(t, ctx)
+	require.EqualValues(t, &types.EventGameRejected{
+		Creator:   bob,
+		GameIndex: "1",
+	}, typed[len(typed)-1])
 }
 
 func TestRejectGameByRedNoMove(t *testing.T) {
@@ -115,9 +133,11 @@ func TestRejectGameByRedNoMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:          2,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
@@ -132,9 +152,19 @@ func TestRejectGameByRedNoMoveEmitted(t *testing.T) {
 		GameIndex: "1",
 	})
 	require.NotNil(t, ctx)
-	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	events := legacyEvents(ctx)
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "*"},
+			{Key: "reason", Value: "rejected"},
+			{Key: "black-payout", Value: "0"},
+			{Key: "red-payout", Value: "0"},
+		},
+	}, events[0])
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -184,9 +214,11 @@ func TestRejectGameByRedOneMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:          2,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
@@ -210,9 +242,19 @@ func TestRejectGameByRedOneMoveEmitted(t *testing.T) {
 		GameIndex: "1",
 	})
 	require.NotNil(t, ctx)
-	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	events := legacyEvents(ctx)
+	require.Len(t, events, 4)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "*"},
+			{Key: "reason", Value: "rejected"},
+			{Key: "black-payout", Value: "0"},
+			{Key: "red-payout", Value: "0"},
+		},
+	}, events[0])
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -2,15 +2,48 @@ package keeper_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	keepertest "github.com/LeTrongDat/checkers/testutil/keeper"
 	"github.com/LeTrongDat/checkers/x/checkers/keeper"
 	"github.com/LeTrongDat/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
 )
 
 func setupMsgServer(t testing.TB) (types.MsgServer, context.Context) {
 	k, ctx := keepertest.CheckersKeeper(t)
 	return keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
 }
+
+// legacyEvents returns the string events, without the typed events emitted
+// next to them.
+func legacyEvents(ctx sdk.Context) sdk.StringEvents {
+	legacy := sdk.Events{}
+	for _, event := range ctx.EventManager().Events() {
+		if !isTypedEvent(event.Type) {
+			legacy = append(legacy, event)
+		}
+	}
+	return sdk.StringifyEvents(legacy.ToABCIEvents())
+}
+
+// typedEvents returns the typed events in the order they were emitted.
+func typedEvents(t testing.TB, ctx sdk.Context) []proto.Message {
+	typed := []proto.Message{}
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if !isTypedEvent(event.Type) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		typed = append(typed, msg)
+	}
+	return typed
+}
+
+func isTypedEvent(eventType string) bool {
+	return strings.HasPrefix(eventType, "letrongdat.checkers.checkers.")
+}
@@ -7,10 +7,32 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.AllowSelfPlay(ctx),
+		k.CreatorMustPlay(ctx),
+		k.RequireAcceptance(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// AllowSelfPlay returns the AllowSelfPlay param
+func (k Keeper) AllowSelfPlay(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyAllowSelfPlay, &res)
+	return
+}
+
+// CreatorMustPlay returns the CreatorMustPlay param
+func (k Keeper) CreatorMustPlay(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyCreatorMustPlay, &res)
+	return
+}
+
+// RequireAcceptance returns the RequireAcceptance param
+func (k Keeper) RequireAcceptance(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyRequireAcceptance, &res)
+	return
+}
@@ -6,6 +6,38 @@ import (
 )
 
 func (k Keeper) RemoveFromFifo(ctx sdk.Context, game *types.StoredGame, info *types.SystemInfo) {
+	k.removeFromList(ctx, game, &info.FifoHeadIndex, &info.FifoTailIndex)
+}
+
+func (k Keeper) SendToFifoTail(ctx sdk.Context, game *types.StoredGame, info *types.SystemInfo) {
+	k.sendToListTail(ctx, game, &info.FifoHeadIndex, &info.FifoTailIndex)
+}
+
+// RemoveFromInvites takes a game out of the list of pending invitations.
+func (k Keeper) RemoveFromInvites(ctx sdk.Context, game *types.StoredGame, info *types.SystemInfo) {
+	k.removeFromList(ctx, game, &info.InviteHeadIndex, &info.InviteTailIndex)
+}
+
+// SendToInvitesTail appends a pending game to the list of invitations, which
+// are all given the same time to be accepted, so the list is sorted by expiry.
+func (k Keeper) SendToInvitesTail(ctx sdk.Context, game *types.StoredGame, info *types.SystemInfo) {
+	k.sendToListTail(ctx, game, &info.InviteHeadIndex, &info.InviteTailIndex)
+}
+
+// RemoveFromQueue takes a game out of the list it is in, depending on whether
+// it is pending.
+func (k Keeper) RemoveFromQueue(ctx sdk.Context, game *types.StoredGame, info *types.SystemInfo) {
+	if game.IsPending() {
+		k.RemoveFromInvites(ctx, game, info)
+	} else {
+		k.RemoveFromFifo(ctx, game, info)
+	}
+}
+
+// removeFromList and sendToListTail work on the doubly linked list of games
+// whose ends are head and tail. A game is in at most one list at a time, as
+// they share its BeforeIndex and AfterIndex.
+func (k Keeper) removeFromList(ctx sdk.Context, game *types.StoredGame, head *string, tail *string) {
 	if game.BeforeIndex != types.NoFifoIndex {
 		beforeElement, found := k.GetStoredGame(ctx, game.BeforeIndex)
 		if !found {
@@ -14,10 +46,10 @@ func (k Keeper) RemoveFromFifo(ctx sdk.Context, game *types.StoredGame, info *ty
 		beforeElement.AfterIndex = game.AfterIndex
 		k.SetStoredGame(ctx, beforeElement)
 		if game.AfterIndex == types.NoFifoIndex {
-			info.FifoTailIndex = game.AfterIndex
+			*tail = beforeElement.Index
 		}
-	} else if info.FifoHeadIndex == game.Index {
-		info.FifoHeadIndex = game.AfterIndex
+	} else if *head == game.Index {
+		*head = game.AfterIndex
 	}
 
 	if game.AfterIndex != types.NoFifoIndex {
@@ -28,29 +60,29 @@ func (k Keeper) RemoveFromFifo(ctx sdk.Context, game *types.StoredGame, info *ty
 		afterElement.BeforeIndex = game.BeforeIndex
 		k.SetStoredGame(ctx, afterElement)
 		if game.BeforeIndex == types.NoFifoIndex {
-			info.FifoHeadIndex = afterElement.Index
+			*head = afterElement.Index
 		}
-	} else if info.FifoTailIndex == game.Index {
-		info.FifoTailIndex = game.BeforeIndex
+	} else if *tail == game.Index {
+		*tail = game.BeforeIndex
 	}
 	game.BeforeIndex = types.NoFifoIndex
 	game.AfterIndex = types.NoFifoIndex
 }
 
-func (k Keeper) SendToFifoTail(ctx sdk.Context, game *types.StoredGame, info *types.SystemInfo) {
-	if info.FifoHeadIndex == types.NoFifoIndex && info.FifoTailIndex == types.NoFifoIndex {
+func (k Keeper) sendToListTail(ctx sdk.Context, game *types.StoredGame, head *string, tail *string) {
+	if *head == types.NoFifoIndex && *tail == types.NoFifoIndex {
 		game.BeforeIndex = types.NoFifoIndex
 		game.AfterIndex = types.NoFifoIndex
-		info.FifoHeadIndex = game.Index
-		info.FifoTailIndex = game.Index
-	} else if info.FifoHeadIndex == types.NoFifoIndex || info.FifoTailIndex == types.NoFifoIndex {
+		*head = game.Index
+		*tail = game.Index
+	} else if *head == types.NoFifoIndex || *tail == types.NoFifoIndex {
 		panic("Fifo should have both head and tail or none")
-	} else if info.FifoTailIndex == game.Index {
+	} else if *tail == game.Index {
 		// nothing to-do
 	} else {
-		k.RemoveFromFifo(ctx, game, info)
+		k.removeFromList(ctx, game, head, tail)
 
-		currentTail, found := k.GetStoredGame(ctx, info.FifoTailIndex)
+		currentTail, found := k.GetStoredGame(ctx, *tail)
 		if !found {
 			panic("Current fifo tail was not found")
 		}
@@ -58,6 +90,6 @@ func (k Keeper) SendToFifoTail(ctx sdk.Context, game *types.StoredGame, info *ty
 		k.SetStoredGame(ctx, currentTail)
 
 		game.BeforeIndex = currentTail.Index
-		info.FifoTailIndex = game.Index
+		*tail = game.Index
 	}
 }
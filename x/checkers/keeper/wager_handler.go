@@ -8,8 +8,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// CollectWager escrows the wager of a player on their first move, and records
+// it as their deposit.
 func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) error {
-	if storedGame.MoveCount == 0 {
+	if storedGame.MoveCount == 0 && storedGame.BlackDeposit == 0 {
 		// black plays first
 		black, err := storedGame.GetBlackAddress()
 		if err != nil {
@@ -19,7 +21,8 @@ func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) err
 		if err != nil {
 			return sdkerrors.Wrapf(err, types.ErrBlackCannotPay.Error())
 		}
-	} else if storedGame.MoveCount == 1 {
+		storedGame.BlackDeposit = storedGame.Wager
+	} else if storedGame.MoveCount == 1 && storedGame.RedDeposit == 0 {
 		// red plays second
 		red, err := storedGame.GetRedAddress()
 		if err != nil {
@@ -29,9 +32,12 @@ func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) err
 		if err != nil {
 			return sdkerrors.Wrapf(err, types.ErrRedCannotPay.Error())
 		}
+		storedGame.RedDeposit = storedGame.Wager
 	}
 	return nil
 }
+
+// MustPayWinnings sends both deposits to the winner, and clears them.
 func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame) {
 	winnerAddress, found, err := storedGame.GetWinnerAddress()
 	if err != nil {
@@ -40,32 +46,41 @@ func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame)
 	if !found {
 		panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Winner))
 	}
-	winnings := storedGame.GetWagerCoin()
-	if storedGame.MoveCount == 0 {
+	if storedGame.GetEscrowed() == 0 && storedGame.Wager != 0 {
 		panic(types.ErrNothingToPay.Error())
-	} else if 1 < storedGame.MoveCount {
-		winnings = winnings.Add(winnings)
 	}
-	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, sdk.NewCoins(winnings))
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, sdk.NewCoins(storedGame.GetEscrowedCoin()))
 	if err != nil {
 		panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
 	}
+	storedGame.BlackDeposit = 0
+	storedGame.RedDeposit = 0
 }
+
+// MustRefundWager gives each player back what they deposited, and clears the
+// deposits. It is what ends a game without a winner.
 func (k *Keeper) MustRefundWager(ctx sdk.Context, storedGame *types.StoredGame) {
-	if storedGame.MoveCount == 1 {
-		// refund
+	if storedGame.BlackDeposit != 0 {
 		black, err := storedGame.GetBlackAddress()
 		if err != nil {
 			panic(err.Error())
 		}
-		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, black, sdk.NewCoins(storedGame.GetWagerCoin()))
+		k.mustRefund(ctx, black, storedGame.BlackDeposit)
+		storedGame.BlackDeposit = 0
+	}
+	if storedGame.RedDeposit != 0 {
+		red, err := storedGame.GetRedAddress()
 		if err != nil {
-			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+			panic(err.Error())
 		}
-	} else if storedGame.MoveCount == 0 {
-		// do nothing
-	} else {
-		// todo
-		panic(fmt.Sprintf(types.ErrNotInRefundState.Error(), storedGame.MoveCount))
+		k.mustRefund(ctx, red, storedGame.RedDeposit)
+		storedGame.RedDeposit = 0
+	}
+}
+
+func (k *Keeper) mustRefund(ctx sdk.Context, player sdk.AccAddress, amount uint64) {
+	err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, player, sdk.NewCoins(types.GetStakeCoin(amount)))
+	if err != nil {
+		panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
 	}
 }
@@ -31,7 +31,7 @@ func TestWagerHandlerCollectWrongNoBlack(t *testing.T) {
 	defer func() {
 		r := recover()
 		require.NotNil(t, r, "The code did not panic")
-		require.Equal(t, "black address is invalid: empty string is not allowed", r)
+		require.Equal(t, ": empty address string is not allowed: black address is invalid", r)
 	}()
 	keeper.CollectWager(ctx, &types.StoredGame{
 		MoveCount: 0,
@@ -83,11 +83,12 @@ func TestWagerHandlerPayWrongEscrowFailed(t *testing.T) {
 		require.Equal(t, r, "cannot pay winnings to winner: Oops")
 	}()
 	keeper.MustPayWinnings(ctx, &types.StoredGame{
-		Black:     alice,
-		Red:       bob,
-		Winner:    "b",
-		MoveCount: 1,
-		Wager:     45,
+		Black:        alice,
+		Red:          bob,
+		Winner:       "b",
+		MoveCount:    1,
+		Wager:        45,
+		BlackDeposit: 45,
 	})
 }
 
@@ -96,21 +97,86 @@ func TestWagerHandlerPayEscrowCalledTwoMoves(t *testing.T) {
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
 	escrow.ExpectRefund(context, alice, 90)
-	keeper.MustPayWinnings(ctx, &types.StoredGame{
-		Black:     alice,
-		Red:       bob,
-		Winner:    "b",
-		MoveCount: 2,
-		Wager:     45,
-	})
+	storedGame := types.StoredGame{
+		Black:        alice,
+		Red:          bob,
+		Winner:       "b",
+		MoveCount:    2,
+		Wager:        45,
+		BlackDeposit: 45,
+		RedDeposit:   45,
+	}
+	keeper.MustPayWinnings(ctx, &storedGame)
+	require.EqualValues(t, 0, storedGame.GetEscrowed())
 }
 
 func TestPlayMoveUpToWinnerCalledBank(t *testing.T) {
 	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	defer ctrl.Finish()
 	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
-	escrow.ExpectRefund(context, bob, 90).Times(1).After(payCarol)
+	payAlice := escrow.ExpectPay(context, alice, 45).Times(1).After(payBob)
+	escrow.ExpectRefund(context, bob, 90).Times(1).After(payAlice)
 
 	playAllMoves(t, msgServer, context, "1", game1Moves)
 }
+
+func TestWagerHandlerRefundBothDeposits(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, alice, 45).Times(1)
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	storedGame := types.StoredGame{
+		Black:        alice,
+		Red:          bob,
+		MoveCount:    7,
+		Wager:        45,
+		BlackDeposit: 45,
+		RedDeposit:   45,
+	}
+	keeper.MustRefundWager(ctx, &storedGame)
+	require.EqualValues(t, 0, storedGame.BlackDeposit)
+	require.EqualValues(t, 0, storedGame.RedDeposit)
+}
+
+func TestWagerHandlerRefundNoDeposit(t *testing.T) {
+	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.MustRefundWager(ctx, &types.StoredGame{
+		Black:     alice,
+		Red:       bob,
+		MoveCount: 0,
+		Wager:     45,
+	})
+}
+
+func TestWagerHandlerCollectOnlyOnce(t *testing.T) {
+	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	err := keeper.CollectWager(ctx, &types.StoredGame{
+		Black:        alice,
+		MoveCount:    0,
+		Wager:        45,
+		BlackDeposit: 45,
+	})
+	require.Nil(t, err)
+}
+
+func TestRejectGameByRedRefundsBlackDeposit(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	pay := escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, bob, 45).Times(1).After(pay)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		Move:      "11-15",
+	})
+	_, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+}
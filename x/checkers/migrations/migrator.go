@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"github.com/LeTrongDat/checkers/x/checkers/keeper"
+	v3 "github.com/LeTrongDat/checkers/x/checkers/migrations/v3"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper keeper.Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper keeper.Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper)
+}
@@ -0,0 +1,76 @@
+package v3
+
+import (
+	"github.com/LeTrongDat/checkers/x/checkers/keeper"
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ConsensusVersion is the version of the checkers state this package migrates from.
+	ConsensusVersion uint64 = 2
+)
+
+// MigrateStore migrates the checkers state from version 2 to version 3:
+//   - the params, which did not exist, take their default values,
+//   - the list of pending invitations starts empty,
+//   - unfinished games get their variant, hash and the deposits their players
+//     made so far, as they were collected when black and then red moved first,
+//   - the deadline FIFO is linked again if it is inconsistent, as version 2 could
+//     lose its tail when the last game was removed.
+func MigrateStore(ctx sdk.Context, k keeper.Keeper) error {
+	k.SetParams(ctx, types.DefaultParams())
+
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "system info")
+	}
+	systemInfo.InviteHeadIndex = types.NoFifoIndex
+	systemInfo.InviteTailIndex = types.NoFifoIndex
+
+	storedGames := k.GetAllStoredGame(ctx)
+	games := make(map[string]types.StoredGame, len(storedGames))
+	for i := range storedGames {
+		if err := backfillGame(&storedGames[i]); err != nil {
+			return err
+		}
+		games[storedGames[i].Index] = storedGames[i]
+	}
+
+	if problems := types.FifoProblems(systemInfo, games); len(problems) != 0 {
+		ctx.Logger().Info("rebuilding the checkers game queues", "problems", problems)
+		if err := types.RebuildFifo(&systemInfo, storedGames); err != nil {
+			return err
+		}
+	}
+
+	for _, storedGame := range storedGames {
+		k.SetStoredGame(ctx, storedGame)
+	}
+	k.SetSystemInfo(ctx, systemInfo)
+	return nil
+}
+
+func backfillGame(storedGame *types.StoredGame) error {
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		// The wagers of finished games have been paid out already
+		return nil
+	}
+	if storedGame.Variant == "" {
+		storedGame.Variant = rules.DEFAULT_VARIANT.Name
+	}
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return sdkerrors.Wrapf(err, "game %s", storedGame.Index)
+	}
+	storedGame.Hash = game.Zobrist()
+	if 1 <= storedGame.MoveCount {
+		storedGame.BlackDeposit = storedGame.Wager
+	}
+	if 2 <= storedGame.MoveCount {
+		storedGame.RedDeposit = storedGame.Wager
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package v3_test
+
+import (
+	"testing"
+
+	keepertest "github.com/LeTrongDat/checkers/testutil/keeper"
+	v3 "github.com/LeTrongDat/checkers/x/checkers/migrations/v3"
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	alice = "cosmos1p5tkxnnhh94lvju6pv4c8tg767a2207l89rpx2"
+	bob   = "cosmos16ur8ptycskmasf8jhuh6mlvrwq6ndwq47zqnar"
+)
+
+// getV2Games returns games as version 2 stored them, without variant, hash,
+// invitations or deposits.
+func getV2Games() []types.StoredGame {
+	return []types.StoredGame{
+		{
+			Index:       "1",
+			Board:       rules.New().String(),
+			Turn:        "b",
+			Black:       alice,
+			Red:         bob,
+			BeforeIndex: "-1",
+			AfterIndex:  "2",
+			Deadline:    "2022-06-01 10:00:00 +0000 UTC",
+			Winner:      "*",
+			Wager:       10,
+		},
+		{
+			Index:       "2",
+			Board:       rules.New().String(),
+			Turn:        "r",
+			Black:       alice,
+			Red:         bob,
+			MoveCount:   3,
+			BeforeIndex: "1",
+			AfterIndex:  "-1",
+			Deadline:    "2022-06-01 11:00:00 +0000 UTC",
+			Winner:      "*",
+			Wager:       20,
+		},
+		{
+			Index:       "3",
+			Black:       alice,
+			Red:         bob,
+			MoveCount:   12,
+			BeforeIndex: "-1",
+			AfterIndex:  "-1",
+			Deadline:    "2022-06-01 09:00:00 +0000 UTC",
+			Winner:      "r",
+			Wager:       30,
+		},
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	for _, storedGame := range getV2Games() {
+		k.SetStoredGame(ctx, storedGame)
+	}
+	// Version 2 lost the tail when game 3 was removed from the FIFO
+	k.SetSystemInfo(ctx, types.SystemInfo{
+		NextId:        4,
+		FifoHeadIndex: "1",
+		FifoTailIndex: "-1",
+	})
+
+	require.Nil(t, v3.MigrateStore(ctx, *k))
+
+	require.EqualValues(t, types.DefaultParams(), k.GetParams(ctx))
+	systemInfo, found := k.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:          4,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "2",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
+	}, systemInfo)
+
+	games := getV2Games()
+	for i := range games[:2] {
+		games[i].Variant = "american"
+		game, err := games[i].ParseGame()
+		require.Nil(t, err)
+		games[i].Hash = game.Zobrist()
+	}
+	games[1].BlackDeposit = 20
+	games[1].RedDeposit = 20
+	require.EqualValues(t, games, k.GetAllStoredGame(ctx))
+
+	genesis := types.GenesisState{
+		Params:         k.GetParams(ctx),
+		SystemInfo:     &systemInfo,
+		StoredGameList: k.GetAllStoredGame(ctx),
+	}
+	require.Nil(t, genesis.Validate())
+}
+
+func TestMigrateStoreDepositAfterBlackMoved(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	storedGame := getV2Games()[0]
+	storedGame.MoveCount = 1
+	storedGame.AfterIndex = "-1"
+	k.SetStoredGame(ctx, storedGame)
+	k.SetSystemInfo(ctx, types.SystemInfo{
+		NextId:        2,
+		FifoHeadIndex: "1",
+		FifoTailIndex: "1",
+	})
+
+	require.Nil(t, v3.MigrateStore(ctx, *k))
+
+	migrated, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, 10, migrated.BlackDeposit)
+	require.EqualValues(t, 0, migrated.RedDeposit)
+}
+
+func TestMigrateStoreNoSystemInfo(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	require.EqualError(t, v3.MigrateStore(ctx, *k), "system info: not found")
+}
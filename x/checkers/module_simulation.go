@@ -1,6 +1,7 @@
 package checkers
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/LeTrongDat/checkers/testutil/sample"
@@ -25,16 +26,17 @@ var (
 
 const (
 	opWeightMsgCreateGame = "op_weight_msg_create_game"
-	// TODO: Determine the simulation weight value
-	defaultWeightMsgCreateGame int = 100
+	// Fewer games than moves, so that some games last long enough to be won
+	defaultWeightMsgCreateGame int = 40
 
-	opWeightMsgPlayMove = "op_weight_msg_play_move"
-	// TODO: Determine the simulation weight value
+	opWeightMsgPlayMove          = "op_weight_msg_play_move"
 	defaultWeightMsgPlayMove int = 100
 
-	opWeightMsgRejectGame = "op_weight_msg_reject_game"
-	// TODO: Determine the simulation weight value
-	defaultWeightMsgRejectGame int = 100
+	opWeightMsgRejectGame          = "op_weight_msg_reject_game"
+	defaultWeightMsgRejectGame int = 10
+
+	opWeightMsgAcceptInvite          = "op_weight_msg_accept_invite"
+	defaultWeightMsgAcceptInvite int = 40
 
 	// this line is used by starport scaffolding # simapp/module/const
 )
@@ -45,8 +47,12 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	params := checkerssimulation.RandomizedParams(simState)
+	systemInfo, storedGames := checkerssimulation.RandomizedStoredGames(simState, params.RequireAcceptance)
 	checkersGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:         params,
+		SystemInfo:     &systemInfo,
+		StoredGameList: storedGames,
 		// this line is used by starport scaffolding # simapp/module/genesisState
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&checkersGenesis)
@@ -59,8 +65,14 @@ func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedP
 
 // RandomizedParams creates randomized  param changes for the simulator
 func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
-
-	return []simtypes.ParamChange{}
+	randomBool := func(r *rand.Rand) string {
+		return fmt.Sprintf("%v", r.Intn(2) == 0)
+	}
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyAllowSelfPlay), randomBool),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyCreatorMustPlay), randomBool),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRequireAcceptance), randomBool),
+	}
 }
 
 // RegisterStoreDecoder registers a decoder
@@ -103,6 +115,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		checkerssimulation.SimulateMsgRejectGame(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgAcceptInvite int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptInvite, &weightMsgAcceptInvite, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptInvite = defaultWeightMsgAcceptInvite
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptInvite,
+		checkerssimulation.SimulateMsgAcceptInvite(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -0,0 +1,292 @@
+package rules
+
+import (
+	"math/bits"
+)
+
+// Bitboard packs a position of the 8x8 board in masks over its 32 usable
+// squares. Square s is at row s/4, and is the (s%4)th usable square of that row.
+// It only knows short kings, forward-capturing men and no majority rule, i.e.
+// the rules that the variants fitting a bitboard share.
+type Bitboard struct {
+	Black uint32
+	Red   uint32
+	Kings uint32
+}
+
+// BitMove is a single step on a bitboard. Captured is NO_SQUARE for a
+// non-capturing move.
+type BitMove struct {
+	From     int
+	To       int
+	Captured int
+}
+
+const (
+	BITBOARD_SQUARES = 32
+	NO_SQUARE        = -1
+)
+
+// bitStep shifts the squares of even and odd rows one step in a direction,
+// dropping those that would leave the board.
+type bitStep struct {
+	evenMask  uint32
+	oddMask   uint32
+	evenShift int
+	oddShift  int
+}
+
+// bitSteps are indexed like Directions
+var bitSteps [4]bitStep
+
+// Rows where men are crowned
+var blackKingRow, redKingRow uint32
+
+func init() {
+	usable := Geometries[BOARD_DIM].Usable
+	for square := 0; square < BITBOARD_SQUARES; square++ {
+		pos := SquarePos(square)
+		for dir, direction := range Directions {
+			next := pos.Add(direction)
+			if !usable[next] {
+				continue
+			}
+			shift := Square(next) - square
+			if pos.Y%2 == 0 {
+				bitSteps[dir].evenMask |= 1 << square
+				bitSteps[dir].evenShift = shift
+			} else {
+				bitSteps[dir].oddMask |= 1 << square
+				bitSteps[dir].oddShift = shift
+			}
+		}
+		if pos.Y == BOARD_DIM-1 {
+			blackKingRow |= 1 << square
+		} else if pos.Y == 0 {
+			redKingRow |= 1 << square
+		}
+	}
+}
+
+// Square returns the bitboard index of a usable position of the 8x8 board.
+func Square(pos Pos) int {
+	return pos.Y*BOARD_DIM/2 + pos.X/2
+}
+
+func SquarePos(square int) Pos {
+	y := square / (BOARD_DIM / 2)
+	return Pos{X: 2*(square%(BOARD_DIM/2)) + (y+1)%2, Y: y}
+}
+
+func shift(mask uint32, n int) uint32 {
+	if n >= 0 {
+		return mask << n
+	}
+	return mask >> -n
+}
+
+func step(dir int, mask uint32) uint32 {
+	st := bitSteps[dir]
+	return shift(mask&st.evenMask, st.evenShift) | shift(mask&st.oddMask, st.oddShift)
+}
+
+func opposite(dir int) int {
+	return len(Directions) - 1 - dir
+}
+
+// forward returns the directions in which the men of a player move.
+func forward(player Player) []int {
+	if player == BLACK_PLAYER {
+		return []int{0, 1}
+	}
+	return []int{2, 3}
+}
+
+func backward(player Player) []int {
+	return forward(Opponents[player])
+}
+
+// BitboardOf packs the pieces of the game, or returns false when its variant
+// does not fit a bitboard. Pieces already captured in the sequence are left
+// out: short-range pieces can neither land on nor jump over their squares
+// again, so it makes no difference that they are still on the board.
+func BitboardOf(game *Game) (Bitboard, bool) {
+	if !game.Variant.FitsBitboard() {
+		return Bitboard{}, false
+	}
+	var bb Bitboard
+	for pos, piece := range game.Pieces {
+		if !game.IsCaptured(pos) {
+			bb.set(pos, piece)
+		}
+	}
+	return bb, true
+}
+
+// ComputeBitboard packs the pieces from scratch for Game.Bitboard, and returns
+// nil when the variant does not fit a bitboard.
+func (game *Game) ComputeBitboard() *Bitboard {
+	if bb, ok := BitboardOf(game); ok {
+		return &bb
+	}
+	return nil
+}
+
+func (bb *Bitboard) set(pos Pos, piece Piece) {
+	bit := uint32(1) << Square(pos)
+	if piece.Player == BLACK_PLAYER {
+		bb.Black |= bit
+	} else {
+		bb.Red |= bit
+	}
+	if piece.King {
+		bb.Kings |= bit
+	}
+}
+
+func (bb *Bitboard) clear(pos Pos) {
+	mask := ^(uint32(1) << Square(pos))
+	bb.Black &= mask
+	bb.Red &= mask
+	bb.Kings &= mask
+}
+
+func (bb Bitboard) Empty() uint32 {
+	return ^(bb.Black | bb.Red)
+}
+
+func (bb Bitboard) Pieces(player Player) uint32 {
+	if player == BLACK_PLAYER {
+		return bb.Black
+	}
+	return bb.Red
+}
+
+// Movers returns the pieces of the player that can make a non-capturing move.
+func (bb Bitboard) Movers(player Player) uint32 {
+	own := bb.Pieces(player)
+	empty := bb.Empty()
+	var movers uint32
+	for _, dir := range forward(player) {
+		movers |= step(opposite(dir), empty) & own
+	}
+	for _, dir := range backward(player) {
+		movers |= step(opposite(dir), empty) & own & bb.Kings
+	}
+	return movers
+}
+
+// Jumpers returns the pieces of the player that can capture.
+func (bb Bitboard) Jumpers(player Player) uint32 {
+	own := bb.Pieces(player)
+	opponents := bb.Pieces(Opponents[player])
+	empty := bb.Empty()
+	var jumpers uint32
+	for _, dir := range forward(player) {
+		jumpers |= step(opposite(dir), step(opposite(dir), empty)&opponents) & own
+	}
+	for _, dir := range backward(player) {
+		jumpers |= step(opposite(dir), step(opposite(dir), empty)&opponents) & own & bb.Kings
+	}
+	return jumpers
+}
+
+func (bb Bitboard) directionsOf(player Player, square int) []int {
+	if bb.Kings&(1<<square) != 0 {
+		return []int{0, 1, 2, 3}
+	}
+	return forward(player)
+}
+
+// JumpsFrom lists the captures that the piece of the player on square can make.
+func (bb Bitboard) JumpsFrom(player Player, square int) []BitMove {
+	opponents := bb.Pieces(Opponents[player])
+	empty := bb.Empty()
+	jumps := []BitMove{}
+	for _, dir := range bb.directionsOf(player, square) {
+		over := step(dir, 1<<square) & opponents
+		land := step(dir, over) & empty
+		if land != 0 {
+			jumps = append(jumps, BitMove{square, bits.TrailingZeros32(land), bits.TrailingZeros32(over)})
+		}
+	}
+	return jumps
+}
+
+// MovesFrom lists the non-capturing moves of the piece of the player on square.
+func (bb Bitboard) MovesFrom(player Player, square int) []BitMove {
+	empty := bb.Empty()
+	moves := []BitMove{}
+	for _, dir := range bb.directionsOf(player, square) {
+		if land := step(dir, 1<<square) & empty; land != 0 {
+			moves = append(moves, BitMove{square, bits.TrailingZeros32(land), NO_SQUARE})
+		}
+	}
+	return moves
+}
+
+// Steps lists the legal single steps of the player. Capturing is the square of
+// the piece in the middle of a capture sequence, or NO_SQUARE.
+func (bb Bitboard) Steps(player Player, capturing int) []BitMove {
+	if capturing != NO_SQUARE {
+		return bb.JumpsFrom(player, capturing)
+	}
+	steps := []BitMove{}
+	if jumpers := bb.Jumpers(player); jumpers != 0 {
+		for ; jumpers != 0; jumpers &= jumpers - 1 {
+			steps = append(steps, bb.JumpsFrom(player, bits.TrailingZeros32(jumpers))...)
+		}
+		return steps
+	}
+	for movers := bb.Movers(player); movers != 0; movers &= movers - 1 {
+		steps = append(steps, bb.MovesFrom(player, bits.TrailingZeros32(movers))...)
+	}
+	return steps
+}
+
+// Apply plays a legal step of the player, and returns the new position, whose
+// turn it is and the piece that has to go on capturing, as Game.Move does.
+func (bb Bitboard) Apply(player Player, move BitMove) (next Bitboard, turn Player, capturing int) {
+	from := uint32(1) << move.From
+	to := uint32(1) << move.To
+	next = bb
+	if player == BLACK_PLAYER {
+		next.Black ^= from | to
+	} else {
+		next.Red ^= from | to
+	}
+	if next.Kings&from != 0 {
+		next.Kings ^= from | to
+	}
+	capturing = NO_SQUARE
+	if move.Captured != NO_SQUARE {
+		captured := ^(uint32(1) << move.Captured)
+		next.Black &= captured
+		next.Red &= captured
+		next.Kings &= captured
+		if len(next.JumpsFrom(player, move.To)) > 0 {
+			capturing = move.To
+		}
+	}
+	if capturing == NO_SQUARE {
+		if (player == BLACK_PLAYER && to&blackKingRow != 0) || (player == RED_PLAYER && to&redKingRow != 0) {
+			next.Kings |= to
+		}
+	}
+	turn = player
+	opponent := Opponents[player]
+	if capturing == NO_SQUARE && next.Movers(opponent)|next.Jumpers(opponent) != 0 {
+		turn = opponent
+	}
+	return next, turn, capturing
+}
+
+// FitsBitboard tells whether games of this variant can be played on a Bitboard.
+func (variant *Variant) FitsBitboard() bool {
+	return variant.BoardDim == BOARD_DIM &&
+		!variant.FlyingKings &&
+		!variant.MenCaptureBackward &&
+		variant.MenCaptureKings &&
+		!variant.MaximumCapture &&
+		!variant.PromoteDuringCapture
+}
@@ -0,0 +1,68 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func requireSamePerft(t *testing.T, game *rules.Game, depth int) {
+	_, ok := rules.BitboardOf(game)
+	require.True(t, ok)
+	for d := 1; d <= depth; d++ {
+		require.Equal(t, rules.StepPerft(game, d), rules.Perft(game, d), "depth %d", d)
+	}
+}
+
+func TestSquareRoundTrip(t *testing.T) {
+	for square := 0; square < rules.BITBOARD_SQUARES; square++ {
+		pos := rules.SquarePos(square)
+		require.True(t, rules.Usable[pos], "%v", pos)
+		require.Equal(t, square, rules.Square(pos))
+	}
+}
+
+func TestBitboardOfNewGame(t *testing.T) {
+	bb, ok := rules.BitboardOf(rules.New())
+	require.True(t, ok)
+	require.Equal(t, uint32(0x00000fff), bb.Black)
+	require.Equal(t, uint32(0xfff00000), bb.Red)
+	require.Equal(t, uint32(0), bb.Kings)
+	require.Equal(t, uint32(0x00000f00), bb.Movers(rules.BLACK_PLAYER))
+	require.Equal(t, uint32(0), bb.Jumpers(rules.BLACK_PLAYER))
+}
+
+func TestBitboardOfOtherVariant(t *testing.T) {
+	game, err := rules.NewGame(rules.RUSSIAN_VARIANT)
+	require.Nil(t, err)
+	_, ok := rules.BitboardOf(game)
+	require.False(t, ok)
+}
+
+func TestBitboardPerftMatchesMapFromStart(t *testing.T) {
+	requireSamePerft(t, rules.New(), 6)
+}
+
+func TestBitboardPerftMatchesMapWithKings(t *testing.T) {
+	game, err := rules.Parse("*b*b*B**|b*****b*|***r*b*b|**b*****|*R*r*r**|r*****r*|*r*r***r|**B*r*r*")
+	require.Nil(t, err)
+	requireSamePerft(t, game, 5)
+	game.Turn = rules.RED_PLAYER
+	requireSamePerft(t, game, 5)
+}
+
+func TestBitboardPerftMatchesMapMidCapture(t *testing.T) {
+	game := gameWith(t, rules.AMERICAN_VARIANT, map[rules.Pos]rules.Piece{
+		{X: 1, Y: 0}: blackMan,
+		{X: 2, Y: 1}: redMan,
+		{X: 4, Y: 3}: redMan,
+		{X: 7, Y: 0}: blackMan,
+		{X: 6, Y: 1}: redMan,
+		{X: 4, Y: 7}: redKing,
+	})
+	_, err := game.Move(rules.Pos{X: 1, Y: 0}, rules.Pos{X: 3, Y: 2})
+	require.Nil(t, err)
+	require.NotEqual(t, rules.NO_POS, game.Capturing)
+	requireSamePerft(t, game, 5)
+}
@@ -0,0 +1,264 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	blackMan  = rules.Piece{Player: rules.BLACK_PLAYER, King: false}
+	blackKing = rules.Piece{Player: rules.BLACK_PLAYER, King: true}
+	redMan    = rules.Piece{Player: rules.RED_PLAYER, King: false}
+	redKing   = rules.Piece{Player: rules.RED_PLAYER, King: true}
+)
+
+func gameWith(t *testing.T, variant *rules.Variant, pieces map[rules.Pos]rules.Piece) *rules.Game {
+	game, err := rules.NewGame(variant)
+	require.Nil(t, err)
+	game.Pieces = pieces
+	game.Hash = game.ComputeHash()
+	game.Bitboard = game.ComputeBitboard()
+	return game
+}
+
+func TestAllVariantsAreValid(t *testing.T) {
+	for name, variant := range rules.Variants {
+		require.Nil(t, variant.Validate(), name)
+		require.Equal(t, name, variant.Name)
+	}
+}
+
+func TestFlyingKingMovesFar(t *testing.T) {
+	pieces := map[rules.Pos]rules.Piece{
+		{X: 1, Y: 0}: blackKing,
+		{X: 6, Y: 7}: redMan,
+	}
+	require.False(t, gameWith(t, rules.AMERICAN_VARIANT, pieces).ValidMove(rules.Pos{X: 1, Y: 0}, rules.Pos{X: 6, Y: 5}))
+	game := gameWith(t, rules.POOL_VARIANT, pieces)
+	captured, err := game.Move(rules.Pos{X: 1, Y: 0}, rules.Pos{X: 6, Y: 5})
+	require.Nil(t, err)
+	require.Equal(t, rules.NO_POS, captured)
+	require.True(t, game.TurnIs(rules.RED_PLAYER))
+}
+
+func TestFlyingKingCannotJumpOverOwnPiece(t *testing.T) {
+	game := gameWith(t, rules.POOL_VARIANT, map[rules.Pos]rules.Piece{
+		{X: 1, Y: 0}: blackKing,
+		{X: 3, Y: 2}: blackMan,
+		{X: 6, Y: 7}: redMan,
+	})
+	require.False(t, game.ValidMove(rules.Pos{X: 1, Y: 0}, rules.Pos{X: 4, Y: 3}))
+}
+
+func TestFlyingKingCapturesFromAfar(t *testing.T) {
+	game := gameWith(t, rules.RUSSIAN_VARIANT, map[rules.Pos]rules.Piece{
+		{X: 0, Y: 1}: blackKing,
+		{X: 3, Y: 4}: redMan,
+		{X: 7, Y: 6}: redMan,
+	})
+	captured, err := game.Move(rules.Pos{X: 0, Y: 1}, rules.Pos{X: 6, Y: 7})
+	require.Nil(t, err)
+	require.Equal(t, rules.Pos{X: 3, Y: 4}, captured)
+	require.False(t, game.PieceAt(rules.Pos{X: 3, Y: 4}))
+	require.Equal(t, rules.NO_POS, game.Capturing)
+	require.True(t, game.TurnIs(rules.RED_PLAYER))
+}
+
+func TestFlyingKingCannotCaptureTwoInARow(t *testing.T) {
+	game := gameWith(t, rules.RUSSIAN_VARIANT, map[rules.Pos]rules.Piece{
+		{X: 0, Y: 1}: blackKing,
+		{X: 2, Y: 3}: redMan,
+		{X: 3, Y: 4}: redMan,
+	})
+	require.False(t, game.ValidJump(rules.Pos{X: 0, Y: 1}, rules.Pos{X: 4, Y: 5}))
+}
+
+func TestFlyingKingCannotRecrossCapturedPiece(t *testing.T) {
+	game := gameWith(t, rules.RUSSIAN_VARIANT, map[rules.Pos]rules.Piece{
+		{X: 5, Y: 0}: blackKing,
+		{X: 6, Y: 1}: redMan,
+		{X: 3, Y: 2}: redMan,
+		{X: 6, Y: 3}: redMan,
+		{X: 3, Y: 4}: redMan,
+		{X: 5, Y: 6}: redMan,
+	})
+	for _, step := range []rules.Step{
+		{Src: rules.Pos{X: 5, Y: 0}, Dst: rules.Pos{X: 7, Y: 2}},
+		{Src: rules.Pos{X: 7, Y: 2}, Dst: rules.Pos{X: 4, Y: 5}},
+		{Src: rules.Pos{X: 4, Y: 5}, Dst: rules.Pos{X: 2, Y: 3}},
+	} {
+		_, err := game.Move(step.Src, step.Dst)
+		require.Nil(t, err)
+	}
+	require.Equal(t, rules.Pos{X: 2, Y: 3}, game.Capturing)
+	// The piece taken on 3,4 is still there and blocks the way to 5,6
+	require.True(t, game.PieceAt(rules.Pos{X: 3, Y: 4}))
+	require.True(t, game.IsCaptured(rules.Pos{X: 3, Y: 4}))
+	require.False(t, game.ValidMove(rules.Pos{X: 2, Y: 3}, rules.Pos{X: 4, Y: 5}))
+	require.False(t, game.ValidMove(rules.Pos{X: 2, Y: 3}, rules.Pos{X: 6, Y: 7}))
+	captured, err := game.Move(rules.Pos{X: 2, Y: 3}, rules.Pos{X: 5, Y: 0})
+	require.Nil(t, err)
+	require.Equal(t, rules.Pos{X: 3, Y: 2}, captured)
+	require.Equal(t, rules.NO_POS, game.Capturing)
+	require.Empty(t, game.Captured)
+	require.Equal(t, "*****B**|********|********|********|********|********|*****r**|********", game.String())
+	require.Equal(t, game.ComputeHash(), game.Hash)
+	require.True(t, game.TurnIs(rules.RED_PLAYER))
+}
+
+// requireKingLandsToContinue checks that the king taking 2,3 has to land on
+// 3,4, the only square from which it can go on to take 5,2.
+func requireKingLandsToContinue(t *testing.T, variant *rules.Variant) {
+	game := gameWith(t, variant, map[rules.Pos]rules.Piece{
+		{X: 0, Y: 1}: blackKing,
+		{X: 2, Y: 3}: redMan,
+		{X: 5, Y: 2}: redMan,
+	})
+	require.Equal(t, []rules.Step{
+		{Src: rules.Pos{X: 0, Y: 1}, Dst: rules.Pos{X: 3, Y: 4}},
+	}, game.ValidSteps())
+	_, err := game.Move(rules.Pos{X: 0, Y: 1}, rules.Pos{X: 5, Y: 6})
+	require.EqualError(t, err, "Invalid move: {0 1} to {5 6}")
+	_, err = game.Move(rules.Pos{X: 0, Y: 1}, rules.Pos{X: 3, Y: 4})
+	require.Nil(t, err)
+	require.Equal(t, rules.Pos{X: 3, Y: 4}, game.Capturing)
+}
+
+func TestRussianKingLandsToContinue(t *testing.T) {
+	requireKingLandsToContinue(t, rules.RUSSIAN_VARIANT)
+}
+
+func TestPoolKingLandsToContinue(t *testing.T) {
+	requireKingLandsToContinue(t, rules.POOL_VARIANT)
+}
+
+func TestFlyingKingLandsAnywhereWhenCaptureEnds(t *testing.T) {
+	game := gameWith(t, rules.RUSSIAN_VARIANT, map[rules.Pos]rules.Piece{
+		{X: 0, Y: 1}: blackKing,
+		{X: 2, Y: 3}: redMan,
+		{X: 7, Y: 0}: redMan,
+	})
+	require.Len(t, game.ValidSteps(), 4)
+}
+
+func maximumCapturePieces() map[rules.Pos]rules.Piece {
+	return map[rules.Pos]rules.Piece{
+		{X: 1, Y: 2}: blackMan,
+		{X: 2, Y: 3}: redMan,
+		{X: 5, Y: 0}: blackMan,
+		{X: 6, Y: 1}: redMan,
+		{X: 6, Y: 3}: redMan,
+	}
+}
+
+func TestMaximumCaptureEnforced(t *testing.T) {
+	game := gameWith(t, rules.BRAZILIAN_VARIANT, maximumCapturePieces())
+	require.False(t, game.ValidMove(rules.Pos{X: 1, Y: 2}, rules.Pos{X: 3, Y: 4}))
+	captured, err := game.Move(rules.Pos{X: 5, Y: 0}, rules.Pos{X: 7, Y: 2})
+	require.Nil(t, err)
+	require.Equal(t, rules.Pos{X: 6, Y: 1}, captured)
+	require.Equal(t, rules.Pos{X: 7, Y: 2}, game.Capturing)
+	require.True(t, game.TurnIs(rules.BLACK_PLAYER))
+	captured, err = game.Move(rules.Pos{X: 7, Y: 2}, rules.Pos{X: 5, Y: 4})
+	require.Nil(t, err)
+	require.Equal(t, rules.Pos{X: 6, Y: 3}, captured)
+	require.Equal(t, rules.NO_POS, game.Capturing)
+	require.True(t, game.TurnIs(rules.RED_PLAYER))
+}
+
+func TestFreeCaptureChoiceWithoutMaximumRule(t *testing.T) {
+	game := gameWith(t, rules.POOL_VARIANT, maximumCapturePieces())
+	require.True(t, game.ValidMove(rules.Pos{X: 1, Y: 2}, rules.Pos{X: 3, Y: 4}))
+}
+
+func TestCapturingPieceMustContinue(t *testing.T) {
+	game := gameWith(t, rules.AMERICAN_VARIANT, map[rules.Pos]rules.Piece{
+		{X: 1, Y: 0}: blackMan,
+		{X: 2, Y: 1}: redMan,
+		{X: 4, Y: 3}: redMan,
+		{X: 7, Y: 0}: blackMan,
+		{X: 6, Y: 1}: redMan,
+	})
+	_, err := game.Move(rules.Pos{X: 1, Y: 0}, rules.Pos{X: 3, Y: 2})
+	require.Nil(t, err)
+	require.Equal(t, rules.Pos{X: 3, Y: 2}, game.Capturing)
+	_, err = game.Move(rules.Pos{X: 7, Y: 0}, rules.Pos{X: 5, Y: 2})
+	require.EqualError(t, err, "Invalid move: {7 0} to {5 2}")
+	_, err = game.Move(rules.Pos{X: 3, Y: 2}, rules.Pos{X: 5, Y: 4})
+	require.Nil(t, err)
+}
+
+func TestPromoteDuringCapture(t *testing.T) {
+	game := gameWith(t, rules.RUSSIAN_VARIANT, map[rules.Pos]rules.Piece{
+		{X: 2, Y: 5}: blackMan,
+		{X: 3, Y: 6}: redMan,
+		{X: 6, Y: 5}: redMan,
+	})
+	_, err := game.Move(rules.Pos{X: 2, Y: 5}, rules.Pos{X: 4, Y: 7})
+	require.Nil(t, err)
+	require.True(t, game.Pieces[rules.Pos{X: 4, Y: 7}].King)
+	require.Equal(t, rules.Pos{X: 4, Y: 7}, game.Capturing)
+	captured, err := game.Move(rules.Pos{X: 4, Y: 7}, rules.Pos{X: 7, Y: 4})
+	require.Nil(t, err)
+	require.Equal(t, rules.Pos{X: 6, Y: 5}, captured)
+}
+
+func TestNoPromotionWhenPassingThroughLastRow(t *testing.T) {
+	game := gameWith(t, rules.BRAZILIAN_VARIANT, map[rules.Pos]rules.Piece{
+		{X: 2, Y: 5}: blackMan,
+		{X: 3, Y: 6}: redMan,
+		{X: 5, Y: 6}: redMan,
+		{X: 0, Y: 7}: redMan,
+	})
+	_, err := game.Move(rules.Pos{X: 2, Y: 5}, rules.Pos{X: 4, Y: 7})
+	require.Nil(t, err)
+	require.False(t, game.Pieces[rules.Pos{X: 4, Y: 7}].King)
+	_, err = game.Move(rules.Pos{X: 4, Y: 7}, rules.Pos{X: 6, Y: 5})
+	require.Nil(t, err)
+	require.False(t, game.Pieces[rules.Pos{X: 6, Y: 5}].King)
+}
+
+func TestItalianManCannotCaptureKing(t *testing.T) {
+	pieces := map[rules.Pos]rules.Piece{
+		{X: 1, Y: 2}: blackMan,
+		{X: 2, Y: 3}: redKing,
+	}
+	require.True(t, gameWith(t, rules.AMERICAN_VARIANT, pieces).ValidJump(rules.Pos{X: 1, Y: 2}, rules.Pos{X: 3, Y: 4}))
+	require.False(t, gameWith(t, rules.ITALIAN_VARIANT, pieces).ValidJump(rules.Pos{X: 1, Y: 2}, rules.Pos{X: 3, Y: 4}))
+}
+
+func TestFormatParsePos(t *testing.T) {
+	require.Equal(t, "", rules.FormatPos(rules.NO_POS))
+	require.Equal(t, "3,4", rules.FormatPos(rules.Pos{X: 3, Y: 4}))
+	pos, err := rules.ParsePos("3,4")
+	require.Nil(t, err)
+	require.Equal(t, rules.Pos{X: 3, Y: 4}, pos)
+	pos, err = rules.ParsePos("")
+	require.Nil(t, err)
+	require.Equal(t, rules.NO_POS, pos)
+	_, err = rules.ParsePos("a,b")
+	require.EqualError(t, err, "invalid position: a,b")
+}
+
+func TestBlockedWinner(t *testing.T) {
+	game := gameWith(t, rules.AMERICAN_VARIANT, map[rules.Pos]rules.Piece{
+		{X: 5, Y: 0}: blackMan,
+		{X: 2, Y: 3}: blackMan,
+		{X: 1, Y: 4}: blackMan,
+		{X: 3, Y: 4}: blackMan,
+		{X: 2, Y: 5}: blackMan,
+		{X: 0, Y: 5}: redMan,
+		{X: 0, Y: 7}: redMan,
+	})
+	require.Equal(t, rules.NO_PLAYER, game.BlockedWinner())
+	_, err := game.Move(rules.Pos{X: 5, Y: 0}, rules.Pos{X: 4, Y: 1})
+	require.Nil(t, err)
+	require.Equal(t, rules.NO_PLAYER, game.BlockedWinner())
+	_, err = game.Move(rules.Pos{X: 0, Y: 7}, rules.Pos{X: 1, Y: 6})
+	require.Nil(t, err)
+	require.Equal(t, rules.NO_PLAYER, game.Winner())
+	require.True(t, game.TurnIs(rules.BLACK_PLAYER))
+	require.Equal(t, rules.BLACK_PLAYER, game.BlockedWinner())
+}
@@ -4,16 +4,20 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 )
 
 const (
-	BOARD_DIM = 8
-	RED       = "red"
-	BLACK     = "black"
-	ROW_SEP   = "|"
+	BOARD_DIM     = 8
+	MAX_BOARD_DIM = 10
+	RED           = "red"
+	BLACK         = "black"
+	ROW_SEP       = "|"
 )
 
+var BOARD_DIMS = []int{BOARD_DIM, MAX_BOARD_DIM}
+
 type Player struct {
 	Color string
 }
@@ -46,6 +50,32 @@ type Pos struct {
 
 var NO_POS = Pos{-1, -1}
 
+// Directions are the four diagonal steps
+var Directions = []Pos{{1, 1}, {-1, 1}, {1, -1}, {-1, -1}}
+
+func (pos Pos) Add(offset Pos) Pos {
+	return Pos{pos.X + offset.X, pos.Y + offset.Y}
+}
+
+// FormatPos writes a position as "x,y", and NO_POS as the empty string.
+func FormatPos(pos Pos) string {
+	if pos == NO_POS {
+		return ""
+	}
+	return fmt.Sprintf("%d,%d", pos.X, pos.Y)
+}
+
+func ParsePos(s string) (Pos, error) {
+	if s == "" {
+		return NO_POS, nil
+	}
+	var pos Pos
+	if _, err := fmt.Sscanf(s, "%d,%d", &pos.X, &pos.Y); err != nil {
+		return NO_POS, errors.New(fmt.Sprintf("invalid position: %v", s))
+	}
+	return pos, nil
+}
+
 var BLACK_PLAYER = Player{BLACK}
 var RED_PLAYER = Player{RED}
 var NO_PLAYER = Player{
@@ -62,86 +92,110 @@ var Opponents = map[Player]Player{
 	RED_PLAYER:   BLACK_PLAYER,
 }
 
-var Usable = map[Pos]bool{}
-var Moves = map[Player]map[Pos]map[Pos]bool{}
-var Jumps = map[Player]map[Pos]map[Pos]Pos{}
-var KingMoves = map[Pos]map[Pos]bool{}
-var KingJumps = map[Pos]map[Pos]Pos{}
+// The tables of the default 8x8 board
+var Usable map[Pos]bool
+var Moves map[Player]map[Pos]map[Pos]bool
+var Jumps map[Player]map[Pos]map[Pos]Pos
+var KingMoves map[Pos]map[Pos]bool
+var KingJumps map[Pos]map[Pos]Pos
 
 func Capture(src, dst Pos) Pos {
 	return Pos{(src.X + dst.X) / 2, (src.Y + dst.Y) / 2}
 }
 
 func init() {
-
-	// Initialize usable spaces
-	for y := 0; y < BOARD_DIM; y++ {
-		for x := (y + 1) % 2; x < BOARD_DIM; x += 2 {
-			Usable[Pos{X: x, Y: y}] = true
-		}
-	}
-
-	// Initialize deep maps
-	for _, p := range Players {
-		Moves[p] = map[Pos]map[Pos]bool{}
-		Jumps[p] = map[Pos]map[Pos]Pos{}
-	}
-
-	// Compute possible moves, jumps and captures
-	for pos := range Usable {
-		KingMoves[pos] = map[Pos]bool{}
-		KingJumps[pos] = map[Pos]Pos{}
-		var directions = []int{1, -1}
-		for i, player := range []Player{BLACK_PLAYER, RED_PLAYER} {
-			Moves[player][pos] = map[Pos]bool{}
-			Jumps[player][pos] = map[Pos]Pos{}
-			movOff := 1
-			jmpOff := 2
-			for _, direction := range directions {
-				mov := Pos{pos.X + (movOff * direction), pos.Y + (movOff * directions[i])}
-				if Usable[mov] {
-					Moves[player][pos][mov] = true
-					KingMoves[pos][mov] = true
-				}
-				jmp := Pos{pos.X + (jmpOff * direction), pos.Y + (jmpOff * directions[i])}
-				if Usable[jmp] {
-					capturePos := Capture(pos, jmp)
-					Jumps[player][pos][jmp] = capturePos
-					KingJumps[pos][jmp] = capturePos
-				}
-			}
-		}
-	}
+	geometry := Geometries[BOARD_DIM]
+	Usable = geometry.Usable
+	Moves = geometry.Moves
+	Jumps = geometry.Jumps
+	KingMoves = geometry.KingMoves
+	KingJumps = geometry.KingJumps
 }
 
 type Game struct {
-	Pieces map[Pos]Piece
-	Turn   Player
+	Pieces  map[Pos]Piece
+	Turn    Player
+	Variant *Variant
+	// Capturing is the piece in the middle of a capture sequence, if any
+	Capturing Pos
+	// Captured lists the pieces taken so far in the capture sequence. They stay
+	// on the board until it ends, so that they block the way and cannot be
+	// taken twice (the Turkish strike rule).
+	Captured []Pos
+	// Hash is the Zobrist hash of the pieces, kept up to date by Move
+	Hash uint64
+	// Bitboard packs the pieces, kept up to date by Move, when the variant
+	// fits one. It is nil otherwise.
+	Bitboard *Bitboard
 }
 
 func New() *Game {
+	game, err := NewGame(DEFAULT_VARIANT)
+	if err != nil {
+		panic(err.Error())
+	}
+	return game
+}
+
+func NewGame(variant *Variant) (*Game, error) {
+	if err := variant.Validate(); err != nil {
+		return nil, err
+	}
 	pieces := make(map[Pos]Piece)
-	game := &Game{pieces, BLACK_PLAYER}
+	game := &Game{pieces, BLACK_PLAYER, variant, NO_POS, nil, 0, nil}
 	game.addInitialPieces()
-	return game
+	game.Hash = game.ComputeHash()
+	game.Bitboard = game.ComputeBitboard()
+	return game, nil
 }
 
 func (game *Game) addInitialPieces() {
-	for pos := range Usable {
-		if pos.Y >= 0 && pos.Y < 3 {
+	rows := game.Variant.PieceRows
+	dim := game.Variant.BoardDim
+	for pos := range game.Geometry().Usable {
+		if pos.Y >= 0 && pos.Y < rows {
 			game.Pieces[pos] = Piece{BLACK_PLAYER, false}
 		}
-		if pos.Y >= BOARD_DIM-3 && pos.Y < BOARD_DIM {
+		if pos.Y >= dim-rows && pos.Y < dim {
 			game.Pieces[pos] = Piece{RED_PLAYER, false}
 		}
 	}
 }
 
+func (game *Game) Copy() *Game {
+	pieces := make(map[Pos]Piece, len(game.Pieces))
+	for pos, piece := range game.Pieces {
+		pieces[pos] = piece
+	}
+	captured := append([]Pos(nil), game.Captured...)
+	var bb *Bitboard
+	if game.Bitboard != nil {
+		packed := *game.Bitboard
+		bb = &packed
+	}
+	return &Game{pieces, game.Turn, game.Variant, game.Capturing, captured, game.Hash, bb}
+}
+
+func (game *Game) Geometry() *Geometry {
+	return game.Variant.Geometry()
+}
+
 func (game *Game) PieceAt(pos Pos) bool {
 	_, ok := game.Pieces[pos]
 	return ok
 }
 
+// IsCaptured tells whether the piece at pos was taken earlier in the capture
+// sequence, and only waits for it to end to leave the board.
+func (game *Game) IsCaptured(pos Pos) bool {
+	for _, captured := range game.Captured {
+		if captured == pos {
+			return true
+		}
+	}
+	return false
+}
+
 func (game *Game) TurnIs(player Player) bool {
 	return game.Turn == player
 }
@@ -165,29 +219,203 @@ func (game *Game) Winner() Player {
 	return NO_PLAYER
 }
 
+// BlockedWinner returns the player whose opponent still has pieces but cannot
+// move any of them, once the last move is over. The turn stays with this
+// player then. It returns NO_PLAYER while the game goes on.
+func (game *Game) BlockedWinner() Player {
+	if game.Capturing != NO_POS || game.Winner() != NO_PLAYER {
+		return NO_PLAYER
+	}
+	if game.playerHasMove(Opponents[game.Turn]) {
+		return NO_PLAYER
+	}
+	return game.Turn
+}
+
 func (game *Game) ValidMove(src, dst Pos) bool {
 	if !game.PieceAt(src) || game.PieceAt(dst) {
 		return false
 	}
-	piece := game.Pieces[src]
-	if (!piece.King && Moves[piece.Player][src][dst]) || (piece.King && KingMoves[src][dst]) {
-		return !game.playerHasJump(piece.Player)
+	if game.Capturing != NO_POS && game.Capturing != src {
+		// the piece that captured last has to go on capturing
+		return false
+	}
+	if game.ValidJump(src, dst) {
+		return game.majorityAllows(src, dst)
 	}
-	return game.ValidJump(src, dst)
+	if game.Capturing != NO_POS || !game.movesFrom(src)[dst] {
+		return false
+	}
+	return !game.playerHasJump(game.Pieces[src].Player)
 }
 
 func (game *Game) ValidJump(src, dst Pos) bool {
 	if !game.PieceAt(src) || game.PieceAt(dst) {
 		return false
 	}
+	_, jumpOk := game.jumpsFrom(src)[dst]
+	return jumpOk
+}
+
+// movesFrom returns the destinations of the non-capturing moves of the piece
+// at src, regardless of any obligation to capture.
+func (game *Game) movesFrom(src Pos) map[Pos]bool {
 	piece := game.Pieces[src]
+	geometry := game.Geometry()
 	if !piece.King {
-		capLoc, jumpOk := Jumps[piece.Player][src][dst]
-		return jumpOk && game.PieceAt(capLoc) && game.Pieces[capLoc].Player == Opponents[piece.Player]
-	} else {
-		capLoc, kingJumpOk := KingJumps[src][dst]
-		return kingJumpOk && game.PieceAt(capLoc) && game.Pieces[capLoc].Player == Opponents[piece.Player]
+		return geometry.Moves[piece.Player][src]
+	}
+	if !game.Variant.FlyingKings {
+		return geometry.KingMoves[src]
+	}
+	moves := map[Pos]bool{}
+	for _, direction := range Directions {
+		for pos := src.Add(direction); geometry.Usable[pos] && !game.PieceAt(pos); pos = pos.Add(direction) {
+			moves[pos] = true
+		}
+	}
+	return moves
+}
+
+// jumpsFrom returns the destinations of the captures that the piece at src can
+// make in one step, each with the position of the piece it captures.
+func (game *Game) jumpsFrom(src Pos) map[Pos]Pos {
+	piece := game.Pieces[src]
+	geometry := game.Geometry()
+	jumps := map[Pos]Pos{}
+	if piece.King && game.Variant.FlyingKings {
+		for _, direction := range Directions {
+			capLoc := src.Add(direction)
+			for geometry.Usable[capLoc] && !game.PieceAt(capLoc) {
+				capLoc = capLoc.Add(direction)
+			}
+			if !geometry.Usable[capLoc] || !game.canCapture(piece, capLoc) {
+				continue
+			}
+			landings := []Pos{}
+			continuing := []Pos{}
+			for dst := capLoc.Add(direction); geometry.Usable[dst] && !game.PieceAt(dst); dst = dst.Add(direction) {
+				landings = append(landings, dst)
+				if game.flyingCaptureAfter(piece, src, capLoc, dst) {
+					continuing = append(continuing, dst)
+				}
+			}
+			// The king has to land where it can go on capturing, if it can. The
+			// majority rule already sees to it otherwise.
+			if !game.Variant.MaximumCapture && len(continuing) > 0 {
+				landings = continuing
+			}
+			for _, dst := range landings {
+				jumps[dst] = capLoc
+			}
+		}
+		return jumps
+	}
+	candidates := geometry.Jumps[piece.Player][src]
+	if piece.King || game.Variant.MenCaptureBackward {
+		candidates = geometry.KingJumps[src]
 	}
+	for dst, capLoc := range candidates {
+		if !game.PieceAt(dst) && game.canCapture(piece, capLoc) {
+			jumps[dst] = capLoc
+		}
+	}
+	return jumps
+}
+
+// flyingCaptureAfter tells whether the flying king that leaves src and takes
+// capLoc can capture again once landed on dst. The board is left as it is, as
+// it may be iterated over.
+func (game *Game) flyingCaptureAfter(piece Piece, src, capLoc, dst Pos) bool {
+	geometry := game.Geometry()
+	occupied := func(pos Pos) bool {
+		return pos != src && game.PieceAt(pos)
+	}
+	for _, direction := range Directions {
+		next := dst.Add(direction)
+		for geometry.Usable[next] && !occupied(next) {
+			next = next.Add(direction)
+		}
+		if !geometry.Usable[next] || next == capLoc || !game.canCapture(piece, next) {
+			continue
+		}
+		if land := next.Add(direction); geometry.Usable[land] && !occupied(land) {
+			return true
+		}
+	}
+	return false
+}
+
+func (game *Game) canCapture(piece Piece, capLoc Pos) bool {
+	if !game.PieceAt(capLoc) || game.IsCaptured(capLoc) {
+		return false
+	}
+	captured := game.Pieces[capLoc]
+	if !piece.King && captured.King && !game.Variant.MenCaptureKings {
+		return false
+	}
+	return captured.Player == Opponents[piece.Player]
+}
+
+// majorityAllows tells whether the capture from src to dst is the first step of
+// one of the longest capture sequences, when the variant enforces it.
+func (game *Game) majorityAllows(src, dst Pos) bool {
+	if !game.Variant.MaximumCapture {
+		return true
+	}
+	return game.longestCaptureAfter(src, dst) == game.longestCapture(game.Pieces[src].Player)
+}
+
+// longestCapture returns the number of pieces captured by the longest sequence
+// available to the player.
+func (game *Game) longestCapture(player Player) int {
+	if game.Capturing != NO_POS {
+		return game.longestCaptureFrom(game.Capturing)
+	}
+	longest := 0
+	for loc, piece := range game.Pieces {
+		if piece.Player == player {
+			if length := game.longestCaptureFrom(loc); longest < length {
+				longest = length
+			}
+		}
+	}
+	return longest
+}
+
+func (game *Game) longestCaptureFrom(src Pos) int {
+	longest := 0
+	for dst := range game.jumpsFrom(src) {
+		if length := game.longestCaptureAfter(src, dst); longest < length {
+			longest = length
+		}
+	}
+	return longest
+}
+
+// longestCaptureAfter returns the length of the longest sequence starting with
+// the capture from src to dst. Captured pieces stay on the board until the
+// end, as they do when the sequence is played one step at a time.
+func (game *Game) longestCaptureAfter(src, dst Pos) int {
+	piece := game.Pieces[src]
+	capLoc := game.jumpsFrom(src)[dst]
+	moved := piece
+	if game.Variant.PromoteDuringCapture && game.isKingRow(dst, piece.Player) {
+		moved.King = true
+	}
+	delete(game.Pieces, src)
+	game.Pieces[dst] = moved
+	game.Captured = append(game.Captured, capLoc)
+	length := 1 + game.longestCaptureFrom(dst)
+	game.Captured = game.Captured[:len(game.Captured)-1]
+	delete(game.Pieces, dst)
+	game.Pieces[src] = piece
+	return length
+}
+
+func (game *Game) isKingRow(pos Pos, player Player) bool {
+	return (pos.Y == 0 && player == RED_PLAYER) ||
+		(pos.Y == game.Variant.BoardDim-1 && player == BLACK_PLAYER)
 }
 
 func (game *Game) kingPiece(dst Pos) {
@@ -195,16 +423,15 @@ func (game *Game) kingPiece(dst Pos) {
 		return
 	}
 	piece := game.Pieces[dst]
-	if (dst.Y == 0 && piece.Player == RED_PLAYER) ||
-		(dst.Y == BOARD_DIM-1 && piece.Player == BLACK_PLAYER) {
+	if !piece.King && game.isKingRow(dst, piece.Player) {
 		piece.King = true
-		game.Pieces[dst] = piece
+		game.setPiece(dst, piece)
 	}
 }
 
-func (game *Game) updateTurn(dst Pos, jumped bool) {
+func (game *Game) updateTurn(continuing bool) {
 	opponent := Opponents[game.Turn]
-	if (!jumped || !game.jumpPossibleFrom(dst)) && game.playerHasMove(opponent) {
+	if !continuing && game.playerHasMove(opponent) {
 		game.Turn = opponent
 	}
 }
@@ -213,47 +440,28 @@ func (game *Game) jumpPossibleFrom(src Pos) bool {
 	if !game.PieceAt(src) {
 		return false
 	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		// enumerate all player jumps and return true if one is valid
-		for dst := range Jumps[piece.Player][src] {
-			if game.ValidJump(src, dst) {
-				return true
-			}
-		}
-	} else {
-		// enumerate all king jumps and return true if one is valid
-		for dst := range KingJumps[src] {
-			if game.ValidJump(src, dst) {
-				return true
-			}
-		}
+	if game.Bitboard != nil {
+		return game.Bitboard.Jumpers(game.Pieces[src].Player)&(1<<Square(src)) != 0
 	}
-	return false
+	return len(game.jumpsFrom(src)) > 0
 }
 
 func (game *Game) movePossibleFrom(src Pos) bool {
 	if !game.PieceAt(src) {
 		return false
 	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		for dst := range Moves[piece.Player][src] {
-			if game.ValidMove(src, dst) {
-				return true
-			}
-		}
-	} else {
-		for dst := range KingMoves[src] {
-			if game.ValidMove(src, dst) {
-				return true
-			}
+	for dst := range game.movesFrom(src) {
+		if game.ValidMove(src, dst) {
+			return true
 		}
 	}
 	return false
 }
 
 func (game *Game) playerHasMove(player Player) bool {
+	if bb := game.Bitboard; bb != nil {
+		return bb.Movers(player)|bb.Jumpers(player) != 0
+	}
 	for loc, piece := range game.Pieces {
 		if piece.Player == player && (game.movePossibleFrom(loc) || game.jumpPossibleFrom(loc)) {
 			return true
@@ -263,6 +471,9 @@ func (game *Game) playerHasMove(player Player) bool {
 }
 
 func (game *Game) playerHasJump(player Player) bool {
+	if game.Bitboard != nil {
+		return game.Bitboard.Jumpers(player) != 0
+	}
 	for loc, piece := range game.Pieces {
 		if piece.Player == player && game.jumpPossibleFrom(loc) {
 			return true
@@ -271,6 +482,45 @@ func (game *Game) playerHasJump(player Player) bool {
 	return false
 }
 
+// Step is the move of a piece to one square. A capture sequence is played as
+// several steps.
+type Step struct {
+	Src Pos
+	Dst Pos
+}
+
+// ValidSteps lists the steps that the player whose turn it is can play, sorted
+// so that the order does not depend on map iteration.
+func (game *Game) ValidSteps() []Step {
+	steps := []Step{}
+	for src, piece := range game.Pieces {
+		if piece.Player != game.Turn {
+			continue
+		}
+		for dst := range game.movesFrom(src) {
+			if game.ValidMove(src, dst) {
+				steps = append(steps, Step{src, dst})
+			}
+		}
+		for dst := range game.jumpsFrom(src) {
+			if game.ValidMove(src, dst) {
+				steps = append(steps, Step{src, dst})
+			}
+		}
+	}
+	sort.Slice(steps, func(i, j int) bool {
+		return steps[i].Less(steps[j])
+	})
+	return steps
+}
+
+func (step Step) Less(other Step) bool {
+	if step.Src != other.Src {
+		return step.Src.Y < other.Src.Y || (step.Src.Y == other.Src.Y && step.Src.X < other.Src.X)
+	}
+	return step.Dst.Y < other.Dst.Y || (step.Dst.Y == other.Dst.Y && step.Dst.X < other.Dst.X)
+}
+
 func (game *Game) Move(src, dst Pos) (captured Pos, err error) {
 	captured = NO_POS
 	err = nil
@@ -287,23 +537,42 @@ func (game *Game) Move(src, dst Pos) (captured Pos, err error) {
 		return NO_POS, errors.New(fmt.Sprintf("Invalid move: %v to %v", src, dst))
 	}
 	if game.ValidJump(src, dst) {
-		game.Pieces[dst] = game.Pieces[src]
-		delete(game.Pieces, src)
-		captured = Capture(src, dst)
-		delete(game.Pieces, captured)
+		captured = game.jumpsFrom(src)[dst]
+		game.setPiece(dst, game.Pieces[src])
+		game.removePiece(src)
+		game.Captured = append(game.Captured, captured)
+		if game.Bitboard != nil {
+			// see BitboardOf
+			game.Bitboard.clear(captured)
+		}
 	} else {
-		game.Pieces[dst] = game.Pieces[src]
-		delete(game.Pieces, src)
+		game.setPiece(dst, game.Pieces[src])
+		game.removePiece(src)
+	}
+	if game.Variant.PromoteDuringCapture {
+		game.kingPiece(dst)
 	}
-	game.updateTurn(dst, captured != NO_POS)
-	game.kingPiece(dst)
+	continuing := captured != NO_POS && game.jumpPossibleFrom(dst)
+	if continuing {
+		game.Capturing = dst
+	} else {
+		// a man that only passes through the last row during a capture is not crowned
+		game.kingPiece(dst)
+		game.Capturing = NO_POS
+		for _, pos := range game.Captured {
+			game.removePiece(pos)
+		}
+		game.Captured = nil
+	}
+	game.updateTurn(continuing)
 	return
 }
 
 func (game *Game) String() string {
 	var buf bytes.Buffer
-	for y := 0; y < BOARD_DIM; y++ {
-		for x := 0; x < BOARD_DIM; x++ {
+	dim := game.Variant.BoardDim
+	for y := 0; y < dim; y++ {
+		for x := 0; x < dim; x++ {
 			pos := Pos{x, y}
 			if game.PieceAt(pos) {
 				piece := game.Pieces[pos]
@@ -316,7 +585,7 @@ func (game *Game) String() string {
 				buf.WriteString(PieceStrings[NO_PLAYER])
 			}
 		}
-		if y < (BOARD_DIM - 1) {
+		if y < (dim - 1) {
 			buf.WriteString(ROW_SEP)
 		}
 	}
@@ -329,14 +598,22 @@ func ParsePiece(s string) (Piece, bool) {
 }
 
 func Parse(s string) (*Game, error) {
-	if len(s) != BOARD_DIM*BOARD_DIM+(BOARD_DIM-1) {
+	return ParseVariant(s, DEFAULT_VARIANT)
+}
+
+func ParseVariant(s string, variant *Variant) (*Game, error) {
+	if err := variant.Validate(); err != nil {
+		return nil, err
+	}
+	dim := variant.BoardDim
+	if len(s) != dim*dim+(dim-1) {
 		return nil, errors.New(fmt.Sprintf("invalid board string: %v", s))
 	}
 	pieces := make(map[Pos]Piece)
-	result := &Game{pieces, BLACK_PLAYER}
+	result := &Game{pieces, BLACK_PLAYER, variant, NO_POS, nil, 0, nil}
 	for y, row := range strings.Split(s, ROW_SEP) {
 		for x, c := range strings.Split(row, "") {
-			if x >= BOARD_DIM || y >= BOARD_DIM {
+			if x >= dim || y >= dim {
 				return nil, errors.New(fmt.Sprintf("invalid board, piece out of bounds: %v, %v", x, y))
 			}
 			if piece, ok := ParsePiece(c); !ok {
@@ -346,5 +623,7 @@ func Parse(s string) (*Game, error) {
 			}
 		}
 	}
+	result.Hash = result.ComputeHash()
+	result.Bitboard = result.ComputeBitboard()
 	return result, nil
 }
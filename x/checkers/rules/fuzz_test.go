@@ -0,0 +1,82 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+var fuzzBoards = []string{
+	"*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	"*b*b*B**|b*****b*|***r*b*b|**b*****|*R*r*r**|r*****r*|*r*r***r|**B*r*r*",
+	"********|********|********|********|********|********|********|********",
+	"*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r",
+	"not a board",
+}
+
+func FuzzParse(f *testing.F) {
+	for _, board := range fuzzBoards {
+		f.Add(board)
+	}
+	f.Fuzz(func(t *testing.T, board string) {
+		game, err := rules.Parse(board)
+		if err != nil {
+			require.Nil(t, game)
+			return
+		}
+		reparsed, err := rules.Parse(game.String())
+		require.Nil(t, err)
+		require.Equal(t, game.Pieces, reparsed.Pieces)
+	})
+}
+
+func countAll(game *rules.Game) map[rules.Player]int {
+	return map[rules.Player]int{
+		rules.BLACK_PLAYER: countPieces(game, rules.BLACK_PLAYER),
+		rules.RED_PLAYER:   countPieces(game, rules.RED_PLAYER),
+	}
+}
+
+func FuzzMove(f *testing.F) {
+	for _, board := range fuzzBoards {
+		f.Add(board, false, 1, 2, 2, 3)
+		f.Add(board, true, 0, 5, 1, 4)
+	}
+	f.Fuzz(func(t *testing.T, board string, redTurn bool, fromX, fromY, toX, toY int) {
+		game, err := rules.Parse(board)
+		if err != nil {
+			return
+		}
+		if redTurn {
+			game.Turn = rules.RED_PLAYER
+		}
+		player := game.Turn
+		src := rules.Pos{X: fromX, Y: fromY}
+		dst := rules.Pos{X: toX, Y: toY}
+		valid := false
+		for _, step := range game.ValidSteps() {
+			valid = valid || step == rules.Step{Src: src, Dst: dst}
+		}
+		before := countAll(game)
+		captured, err := game.Move(src, dst)
+		after := countAll(game)
+		if err != nil {
+			require.False(t, valid)
+			require.Equal(t, before, after)
+			return
+		}
+		require.True(t, valid)
+		require.Equal(t, before[player], after[player])
+		opponent := rules.Opponents[player]
+		if captured == rules.NO_POS {
+			require.Equal(t, before[opponent], after[opponent])
+		} else {
+			require.Equal(t, before[opponent]-1, after[opponent])
+		}
+		require.Equal(t, game.ComputeHash(), game.Hash)
+		require.Equal(t, game.ComputeBitboard(), game.Bitboard)
+		_, err = rules.Parse(game.String())
+		require.Nil(t, err)
+	})
+}
@@ -0,0 +1,79 @@
+package rules
+
+// Geometry holds the playable squares of a board size, and the precomputed
+// single-step moves and jumps from each of them.
+type Geometry struct {
+	Dim       int
+	Usable    map[Pos]bool
+	Moves     map[Player]map[Pos]map[Pos]bool
+	Jumps     map[Player]map[Pos]map[Pos]Pos
+	KingMoves map[Pos]map[Pos]bool
+	KingJumps map[Pos]map[Pos]Pos
+}
+
+// Geometries lists the board sizes the engine can play, by dimension.
+var Geometries = newGeometries()
+
+func newGeometries() map[int]*Geometry {
+	geometries := map[int]*Geometry{}
+	for _, dim := range BOARD_DIMS {
+		geometries[dim] = NewGeometry(dim)
+	}
+	return geometries
+}
+
+func NewGeometry(dim int) *Geometry {
+	geometry := &Geometry{
+		Dim:       dim,
+		Usable:    map[Pos]bool{},
+		Moves:     map[Player]map[Pos]map[Pos]bool{},
+		Jumps:     map[Player]map[Pos]map[Pos]Pos{},
+		KingMoves: map[Pos]map[Pos]bool{},
+		KingJumps: map[Pos]map[Pos]Pos{},
+	}
+
+	// Initialize usable spaces
+	for y := 0; y < dim; y++ {
+		for x := (y + 1) % 2; x < dim; x += 2 {
+			geometry.Usable[Pos{X: x, Y: y}] = true
+		}
+	}
+
+	// Initialize deep maps
+	for _, p := range Players {
+		geometry.Moves[p] = map[Pos]map[Pos]bool{}
+		geometry.Jumps[p] = map[Pos]map[Pos]Pos{}
+	}
+
+	// Compute possible moves, jumps and captures
+	for pos := range geometry.Usable {
+		geometry.KingMoves[pos] = map[Pos]bool{}
+		geometry.KingJumps[pos] = map[Pos]Pos{}
+		var directions = []int{1, -1}
+		for i, player := range []Player{BLACK_PLAYER, RED_PLAYER} {
+			geometry.Moves[player][pos] = map[Pos]bool{}
+			geometry.Jumps[player][pos] = map[Pos]Pos{}
+			movOff := 1
+			jmpOff := 2
+			for _, direction := range directions {
+				mov := Pos{pos.X + (movOff * direction), pos.Y + (movOff * directions[i])}
+				if geometry.Usable[mov] {
+					geometry.Moves[player][pos][mov] = true
+					geometry.KingMoves[pos][mov] = true
+				}
+				jmp := Pos{pos.X + (jmpOff * direction), pos.Y + (jmpOff * directions[i])}
+				if geometry.Usable[jmp] {
+					capturePos := Capture(pos, jmp)
+					geometry.Jumps[player][pos][jmp] = capturePos
+					geometry.KingJumps[pos][jmp] = capturePos
+				}
+			}
+		}
+	}
+	return geometry
+}
+
+// InBoard tells whether the position is on the board, whether usable or not.
+func (geometry *Geometry) InBoard(pos Pos) bool {
+	return 0 <= pos.X && pos.X < geometry.Dim && 0 <= pos.Y && pos.Y < geometry.Dim
+}
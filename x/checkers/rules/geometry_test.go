@@ -0,0 +1,79 @@
+package rules_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// A 10x10 ruleset with short kings, so that it only exercises the board size
+var tenByTen = &rules.Variant{
+	Name:            "ten",
+	BoardDim:        10,
+	PieceRows:       4,
+	MenCaptureKings: true,
+}
+
+func countPieces(game *rules.Game, player rules.Player) int {
+	count := 0
+	for _, piece := range game.Pieces {
+		if piece.Player == player {
+			count++
+		}
+	}
+	return count
+}
+
+func TestGeometry10Usable(t *testing.T) {
+	geometry := rules.Geometries[10]
+	require.NotNil(t, geometry)
+	require.Len(t, geometry.Usable, 50)
+	require.True(t, geometry.Usable[rules.Pos{X: 1, Y: 0}])
+	require.False(t, geometry.Usable[rules.Pos{X: 0, Y: 0}])
+	require.True(t, geometry.Usable[rules.Pos{X: 0, Y: 9}])
+	require.True(t, geometry.InBoard(rules.Pos{X: 9, Y: 9}))
+	require.False(t, geometry.InBoard(rules.Pos{X: 10, Y: 9}))
+}
+
+func TestNewGame10HasTwentyPiecesEach(t *testing.T) {
+	game, err := rules.NewGame(tenByTen)
+	require.Nil(t, err)
+	require.Equal(t, 20, countPieces(game, rules.BLACK_PLAYER))
+	require.Equal(t, 20, countPieces(game, rules.RED_PLAYER))
+	require.EqualValues(t,
+		"*b*b*b*b*b|b*b*b*b*b*|*b*b*b*b*b|b*b*b*b*b*|**********|**********|*r*r*r*r*r|r*r*r*r*r*|*r*r*r*r*r|r*r*r*r*r*",
+		game.String())
+}
+
+func TestParse10RoundTrip(t *testing.T) {
+	game, err := rules.NewGame(tenByTen)
+	require.Nil(t, err)
+	parsed, err := rules.ParseVariant(game.String(), tenByTen)
+	require.Nil(t, err)
+	require.EqualValues(t, game.Pieces, parsed.Pieces)
+}
+
+func TestParse10RejectsSmallBoard(t *testing.T) {
+	game, err := rules.ParseVariant(rules.New().String(), tenByTen)
+	require.Nil(t, game)
+	require.True(t, strings.HasPrefix(err.Error(), "invalid board string: "))
+}
+
+func TestMove10(t *testing.T) {
+	game, err := rules.NewGame(tenByTen)
+	require.Nil(t, err)
+	captured, err := game.Move(rules.Pos{X: 0, Y: 3}, rules.Pos{X: 1, Y: 4})
+	require.Nil(t, err)
+	require.Equal(t, rules.NO_POS, captured)
+	require.True(t, game.TurnIs(rules.RED_PLAYER))
+	_, err = game.Move(rules.Pos{X: 1, Y: 6}, rules.Pos{X: 2, Y: 5})
+	require.Nil(t, err)
+}
+
+func TestUnsupportedBoardSize(t *testing.T) {
+	game, err := rules.NewGame(&rules.Variant{Name: "huge", BoardDim: 12, PieceRows: 5})
+	require.Nil(t, game)
+	require.EqualError(t, err, "variant huge: unsupported board size: 12")
+}
@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Squares are numbered from 1, row by row from the side of black, as in the
+// portable draughts notation, so that the openings of the American game read
+// 9-13 to 12-16. In algebraic notation, files are letters from a on the left
+// and ranks are numbers from 1 on the side of red, so that a1 is a dark square.
+const (
+	MOVE_SEP    = "-"
+	CAPTURE_SEP = "x"
+)
+
+// LARGEST_BOARD_VARIANT only has a board size, to check notation before the
+// variant of a game is known. Dark squares are the same on all boards.
+var LARGEST_BOARD_VARIANT = &Variant{Name: "largest", BoardDim: MAX_BOARD_DIM}
+
+// SquareNumber returns the number of a dark square, or 0 if pos is not one.
+func (variant *Variant) SquareNumber(pos Pos) int {
+	geometry := variant.Geometry()
+	if geometry == nil || !geometry.Usable[pos] {
+		return 0
+	}
+	return pos.Y*variant.BoardDim/2 + pos.X/2 + 1
+}
+
+func (variant *Variant) SquareCount() int {
+	return variant.BoardDim * variant.BoardDim / 2
+}
+
+func (variant *Variant) NumberPos(number int) (Pos, error) {
+	if number < 1 || number > variant.SquareCount() {
+		return NO_POS, errors.New(fmt.Sprintf("square out of the board: %d", number))
+	}
+	perRow := variant.BoardDim / 2
+	y := (number - 1) / perRow
+	return Pos{X: 2*((number-1)%perRow) + (y+1)%2, Y: y}, nil
+}
+
+func (variant *Variant) FormatAlgebraic(pos Pos) string {
+	return fmt.Sprintf("%c%d", 'a'+pos.X, variant.BoardDim-pos.Y)
+}
+
+func (variant *Variant) ParseAlgebraic(s string) (Pos, error) {
+	if len(s) < 2 {
+		return NO_POS, errors.New(fmt.Sprintf("invalid square: %s", s))
+	}
+	rank, err := strconv.Atoi(s[1:])
+	if err != nil {
+		return NO_POS, errors.New(fmt.Sprintf("invalid square: %s", s))
+	}
+	pos := Pos{X: int(s[0] - 'a'), Y: variant.BoardDim - rank}
+	if variant.SquareNumber(pos) == 0 {
+		return NO_POS, errors.New(fmt.Sprintf("not a dark square of the board: %s", s))
+	}
+	return pos, nil
+}
+
+// ParseSquare reads a square in numbered or in algebraic notation.
+func (variant *Variant) ParseSquare(s string) (Pos, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if number, err := strconv.Atoi(s); err == nil {
+		return variant.NumberPos(number)
+	}
+	return variant.ParseAlgebraic(s)
+}
+
+// ParseMove reads a move such as 11-15, 11x18x25 or c3-d4 into its steps.
+// Captures list every landing square, so that each step is a single jump.
+func (variant *Variant) ParseMove(s string) ([]Step, error) {
+	squares := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return string(r) == MOVE_SEP || string(r) == CAPTURE_SEP
+	})
+	if len(squares) < 2 {
+		return nil, errors.New(fmt.Sprintf("invalid move: %s", s))
+	}
+	steps := make([]Step, 0, len(squares)-1)
+	src, err := variant.ParseSquare(squares[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, square := range squares[1:] {
+		dst, err := variant.ParseSquare(square)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, Step{Src: src, Dst: dst})
+		src = dst
+	}
+	return steps, nil
+}
+
+// FormatMove writes the steps of a move in numbered notation.
+func (variant *Variant) FormatMove(steps []Step, capture bool) string {
+	if len(steps) == 0 {
+		return ""
+	}
+	sep := MOVE_SEP
+	if capture {
+		sep = CAPTURE_SEP
+	}
+	squares := []string{strconv.Itoa(variant.SquareNumber(steps[0].Src))}
+	for _, step := range steps {
+		squares = append(squares, strconv.Itoa(variant.SquareNumber(step.Dst)))
+	}
+	return strings.Join(squares, sep)
+}
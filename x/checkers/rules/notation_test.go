@@ -0,0 +1,98 @@
+package rules_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSquareNumbersRoundTrip(t *testing.T) {
+	for _, variant := range []*rules.Variant{rules.AMERICAN_VARIANT, rules.INTERNATIONAL_VARIANT} {
+		for number := 1; number <= variant.SquareCount(); number++ {
+			pos, err := variant.NumberPos(number)
+			require.Nil(t, err)
+			require.Equal(t, number, variant.SquareNumber(pos))
+			algebraic, err := variant.ParseAlgebraic(variant.FormatAlgebraic(pos))
+			require.Nil(t, err)
+			require.Equal(t, pos, algebraic)
+		}
+		_, err := variant.NumberPos(variant.SquareCount() + 1)
+		require.EqualError(t, err, fmt.Sprintf("square out of the board: %d", variant.SquareCount()+1))
+	}
+}
+
+func TestSquareNumberOfLightSquare(t *testing.T) {
+	require.Equal(t, 0, rules.AMERICAN_VARIANT.SquareNumber(rules.Pos{X: 0, Y: 0}))
+	require.Equal(t, 1, rules.AMERICAN_VARIANT.SquareNumber(rules.Pos{X: 1, Y: 0}))
+	require.Equal(t, 32, rules.AMERICAN_VARIANT.SquareNumber(rules.Pos{X: 6, Y: 7}))
+}
+
+func TestNumberedOpeningsAreTheValidMoves(t *testing.T) {
+	game := rules.New()
+	moves := []string{}
+	for _, step := range game.ValidSteps() {
+		moves = append(moves, rules.AMERICAN_VARIANT.FormatMove([]rules.Step{step}, false))
+	}
+	require.ElementsMatch(t, []string{"9-13", "9-14", "10-14", "10-15", "11-15", "11-16", "12-16"}, moves)
+}
+
+func TestParseMoveNumbered(t *testing.T) {
+	steps, err := rules.AMERICAN_VARIANT.ParseMove("11-15")
+	require.Nil(t, err)
+	require.Equal(t, []rules.Step{{Src: rules.Pos{X: 5, Y: 2}, Dst: rules.Pos{X: 4, Y: 3}}}, steps)
+}
+
+func TestParseMoveCaptureSequence(t *testing.T) {
+	steps, err := rules.AMERICAN_VARIANT.ParseMove("2x11X18")
+	require.Nil(t, err)
+	require.Equal(t, []rules.Step{
+		{Src: rules.Pos{X: 3, Y: 0}, Dst: rules.Pos{X: 5, Y: 2}},
+		{Src: rules.Pos{X: 5, Y: 2}, Dst: rules.Pos{X: 3, Y: 4}},
+	}, steps)
+	require.Equal(t, "2x11x18", rules.AMERICAN_VARIANT.FormatMove(steps, true))
+}
+
+func TestParseMoveAlgebraic(t *testing.T) {
+	steps, err := rules.AMERICAN_VARIANT.ParseMove("f6-E5")
+	require.Nil(t, err)
+	numbered, err := rules.AMERICAN_VARIANT.ParseMove("11-15")
+	require.Nil(t, err)
+	require.Equal(t, numbered, steps)
+	require.Equal(t, "a1", rules.AMERICAN_VARIANT.FormatAlgebraic(rules.Pos{X: 0, Y: 7}))
+	require.Equal(t, "j10", rules.INTERNATIONAL_VARIANT.FormatAlgebraic(rules.Pos{X: 9, Y: 0}))
+}
+
+func TestParseMoveErrors(t *testing.T) {
+	for move, message := range map[string]string{
+		"11":     "invalid move: 11",
+		"":       "invalid move: ",
+		"11-33":  "square out of the board: 33",
+		"0-4":    "square out of the board: 0",
+		"a2-b3":  "not a dark square of the board: a2",
+		"z9-b3":  "not a dark square of the board: z9",
+		"c3-d":   "invalid square: d",
+		"c3-dd4": "invalid square: dd4",
+	} {
+		_, err := rules.AMERICAN_VARIANT.ParseMove(move)
+		require.EqualError(t, err, message, move)
+	}
+}
+
+func TestRender(t *testing.T) {
+	game := rules.New()
+	game.Pieces[rules.Pos{X: 1, Y: 0}] = rules.Piece{Player: rules.RED_PLAYER, King: true}
+	require.Equal(t, ""+
+		"    a b c d e f g h\n"+
+		" 8    R   b   b   b  8    1  2  3  4\n"+
+		" 7  b   b   b   b    7    5  6  7  8\n"+
+		" 6    b   b   b   b  6    9 10 11 12\n"+
+		" 5  .   .   .   .    5   13 14 15 16\n"+
+		" 4    .   .   .   .  4   17 18 19 20\n"+
+		" 3  r   r   r   r    3   21 22 23 24\n"+
+		" 2    r   r   r   r  2   25 26 27 28\n"+
+		" 1  r   r   r   r    1   29 30 31 32\n"+
+		"    a b c d e f g h\n",
+		rules.Render(game))
+}
@@ -0,0 +1,51 @@
+package rules
+
+// Perft counts the positions reached after depth turns from the game. A
+// capture sequence counts as one turn, so the numbers can be compared with
+// published draughts perft results. Games that fit a bitboard use it.
+func Perft(game *Game, depth int) uint64 {
+	if bb := game.Bitboard; bb != nil {
+		capturing := NO_SQUARE
+		if game.Capturing != NO_POS {
+			capturing = Square(game.Capturing)
+		}
+		return bb.Perft(game.Turn, capturing, depth)
+	}
+	return StepPerft(game, depth)
+}
+
+// StepPerft is Perft computed with ValidSteps and Move only, whatever the variant.
+func StepPerft(game *Game, depth int) uint64 {
+	if depth == 0 {
+		return 1
+	}
+	var nodes uint64
+	for _, step := range game.ValidSteps() {
+		next := game.Copy()
+		if _, err := next.Move(step.Src, step.Dst); err != nil {
+			panic(err.Error())
+		}
+		if next.Capturing != NO_POS {
+			nodes += StepPerft(next, depth)
+		} else {
+			nodes += StepPerft(next, depth-1)
+		}
+	}
+	return nodes
+}
+
+func (bb Bitboard) Perft(player Player, capturing int, depth int) uint64 {
+	if depth == 0 {
+		return 1
+	}
+	var nodes uint64
+	for _, move := range bb.Steps(player, capturing) {
+		next, turn, nextCapturing := bb.Apply(player, move)
+		if nextCapturing != NO_SQUARE {
+			nodes += next.Perft(turn, nextCapturing, depth)
+		} else {
+			nodes += next.Perft(turn, NO_SQUARE, depth-1)
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,91 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// Published perft numbers of English draughts from the initial position
+var americanPerft = []uint64{1, 7, 49, 302, 1469, 7361, 36768, 179740, 845931}
+
+func TestPerftAmerican(t *testing.T) {
+	for depth, expected := range americanPerft {
+		require.Equal(t, expected, rules.Perft(rules.New(), depth), "depth %d", depth)
+	}
+}
+
+func TestStepPerftAmerican(t *testing.T) {
+	for depth, expected := range americanPerft[:6] {
+		require.Equal(t, expected, rules.StepPerft(rules.New(), depth), "depth %d", depth)
+	}
+}
+
+func TestPerftOpeningOtherVariants(t *testing.T) {
+	// Without captures in the first moves, the short-king variants open like
+	// American, and 10x10 offers 9 first moves to each side.
+	for _, variant := range []*rules.Variant{rules.RUSSIAN_VARIANT, rules.BRAZILIAN_VARIANT, rules.ITALIAN_VARIANT, rules.POOL_VARIANT} {
+		game, err := rules.NewGame(variant)
+		require.Nil(t, err)
+		require.Equal(t, uint64(7), rules.Perft(game, 1), variant.Name)
+		require.Equal(t, uint64(49), rules.Perft(game, 2), variant.Name)
+	}
+	game, err := rules.NewGame(rules.INTERNATIONAL_VARIANT)
+	require.Nil(t, err)
+	require.Equal(t, uint64(9), rules.Perft(game, 1))
+	require.Equal(t, uint64(81), rules.Perft(game, 2))
+}
+
+func TestPerftDoesNotChangeGame(t *testing.T) {
+	game := rules.New()
+	before := game.String()
+	rules.StepPerft(game, 3)
+	require.Equal(t, before, game.String())
+	require.True(t, game.TurnIs(rules.BLACK_PLAYER))
+}
+
+func BenchmarkPerft(b *testing.B) {
+	game := rules.New()
+	for i := 0; i < b.N; i++ {
+		rules.Perft(game, 6)
+	}
+}
+
+func BenchmarkStepPerft(b *testing.B) {
+	game := rules.New()
+	for i := 0; i < b.N; i++ {
+		rules.StepPerft(game, 4)
+	}
+}
+
+// BenchmarkStepPerftWithoutBitboard plays the same as BenchmarkStepPerft on
+// the maps only, as games of variants that do not fit a bitboard do.
+func BenchmarkStepPerftWithoutBitboard(b *testing.B) {
+	game := rules.New()
+	game.Bitboard = nil
+	for i := 0; i < b.N; i++ {
+		rules.StepPerft(game, 4)
+	}
+}
+
+func BenchmarkStepPerftInternational(b *testing.B) {
+	game, _ := rules.NewGame(rules.INTERNATIONAL_VARIANT)
+	for i := 0; i < b.N; i++ {
+		rules.StepPerft(game, 3)
+	}
+}
+
+func BenchmarkMove(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		game := rules.New()
+		game.Move(rules.Pos{X: 1, Y: 2}, rules.Pos{X: 2, Y: 3})
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	board := rules.New().String()
+	for i := 0; i < b.N; i++ {
+		rules.Parse(board)
+	}
+}
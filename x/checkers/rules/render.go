@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Name is the color of the player, or nobody, for messages.
+func (player Player) Name() string {
+	if player == NO_PLAYER {
+		return "nobody"
+	}
+	return player.Color
+}
+
+// Render draws the board for a terminal, black at the top, with the files and
+// ranks around it, and the numbers of the dark squares of each row on the right.
+func Render(game *Game) string {
+	dim := game.Variant.BoardDim
+	var buf bytes.Buffer
+	files := make([]string, dim)
+	for x := 0; x < dim; x++ {
+		files[x] = string(rune('a' + x))
+	}
+	header := fmt.Sprintf("    %s\n", strings.Join(files, " "))
+	buf.WriteString(header)
+	for y := 0; y < dim; y++ {
+		rank := dim - y
+		fmt.Fprintf(&buf, "%2d  ", rank)
+		numbers := []string{}
+		for x := 0; x < dim; x++ {
+			pos := Pos{X: x, Y: y}
+			number := game.Variant.SquareNumber(pos)
+			if number > 0 {
+				numbers = append(numbers, fmt.Sprintf("%2d", number))
+			}
+			if piece, found := game.Pieces[pos]; found {
+				val := PieceStrings[piece.Player]
+				if piece.King {
+					val = strings.ToUpper(val)
+				}
+				buf.WriteString(val)
+			} else if number > 0 {
+				buf.WriteString(".")
+			} else {
+				buf.WriteString(" ")
+			}
+			if x < dim-1 {
+				buf.WriteString(" ")
+			}
+		}
+		fmt.Fprintf(&buf, "  %-2d  %s\n", rank, strings.Join(numbers, " "))
+	}
+	buf.WriteString(header)
+	return buf.String()
+}
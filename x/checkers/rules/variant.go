@@ -0,0 +1,148 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	AMERICAN      = "american"
+	INTERNATIONAL = "international"
+	RUSSIAN       = "russian"
+	BRAZILIAN     = "brazilian"
+	ITALIAN       = "italian"
+	POOL          = "pool"
+)
+
+// Variant describes one ruleset of the draughts family. Every game is played
+// under exactly one variant, which never changes during the game.
+type Variant struct {
+	Name string
+	// BoardDim is the number of squares on each side of the board.
+	BoardDim int
+	// PieceRows is the number of rows each player fills with men at the start.
+	PieceRows int
+	// FlyingKings lets kings move and capture along any distance on a diagonal.
+	FlyingKings bool
+	// MenCaptureBackward lets men capture towards their own side.
+	MenCaptureBackward bool
+	// MenCaptureKings is false when a man is not allowed to capture a king.
+	MenCaptureKings bool
+	// MaximumCapture forces the player to take the sequence capturing the most pieces.
+	MaximumCapture bool
+	// PromoteDuringCapture crowns a man as soon as it reaches the last row,
+	// and the capture sequence continues with a king.
+	PromoteDuringCapture bool
+}
+
+var AMERICAN_VARIANT = &Variant{
+	Name:                 AMERICAN,
+	BoardDim:             8,
+	PieceRows:            3,
+	FlyingKings:          false,
+	MenCaptureBackward:   false,
+	MenCaptureKings:      true,
+	MaximumCapture:       false,
+	PromoteDuringCapture: false,
+}
+
+var INTERNATIONAL_VARIANT = &Variant{
+	Name:                 INTERNATIONAL,
+	BoardDim:             10,
+	PieceRows:            4,
+	FlyingKings:          true,
+	MenCaptureBackward:   true,
+	MenCaptureKings:      true,
+	MaximumCapture:       true,
+	PromoteDuringCapture: false,
+}
+
+var RUSSIAN_VARIANT = &Variant{
+	Name:                 RUSSIAN,
+	BoardDim:             8,
+	PieceRows:            3,
+	FlyingKings:          true,
+	MenCaptureBackward:   true,
+	MenCaptureKings:      true,
+	MaximumCapture:       false,
+	PromoteDuringCapture: true,
+}
+
+var BRAZILIAN_VARIANT = &Variant{
+	Name:                 BRAZILIAN,
+	BoardDim:             8,
+	PieceRows:            3,
+	FlyingKings:          true,
+	MenCaptureBackward:   true,
+	MenCaptureKings:      true,
+	MaximumCapture:       true,
+	PromoteDuringCapture: false,
+}
+
+var ITALIAN_VARIANT = &Variant{
+	Name:                 ITALIAN,
+	BoardDim:             8,
+	PieceRows:            3,
+	FlyingKings:          false,
+	MenCaptureBackward:   false,
+	MenCaptureKings:      false,
+	MaximumCapture:       true,
+	PromoteDuringCapture: false,
+}
+
+var POOL_VARIANT = &Variant{
+	Name:                 POOL,
+	BoardDim:             8,
+	PieceRows:            3,
+	FlyingKings:          true,
+	MenCaptureBackward:   true,
+	MenCaptureKings:      true,
+	MaximumCapture:       false,
+	PromoteDuringCapture: false,
+}
+
+var DEFAULT_VARIANT = AMERICAN_VARIANT
+
+var Variants = map[string]*Variant{
+	AMERICAN:      AMERICAN_VARIANT,
+	INTERNATIONAL: INTERNATIONAL_VARIANT,
+	RUSSIAN:       RUSSIAN_VARIANT,
+	BRAZILIAN:     BRAZILIAN_VARIANT,
+	ITALIAN:       ITALIAN_VARIANT,
+	POOL:          POOL_VARIANT,
+}
+
+// VariantByName returns the registered variant with this name. The empty name
+// stands for the default variant, so that games stored before variants existed
+// keep their American rules.
+func VariantByName(name string) (*Variant, error) {
+	if name == "" {
+		return DEFAULT_VARIANT, nil
+	}
+	variant, ok := Variants[name]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("unknown variant: %s", name))
+	}
+	return variant, nil
+}
+
+// Validate checks that the engine is able to play this variant.
+func (variant *Variant) Validate() error {
+	if variant.Geometry() == nil {
+		return errors.New(fmt.Sprintf("variant %s: unsupported board size: %d", variant.Name, variant.BoardDim))
+	}
+	if variant.PieceRows <= 0 || variant.BoardDim <= 2*variant.PieceRows {
+		return errors.New(fmt.Sprintf("variant %s: invalid number of piece rows: %d", variant.Name, variant.PieceRows))
+	}
+	return nil
+}
+
+// Geometry returns the tables of the variant's board, or nil if the engine
+// does not support its size.
+func (variant *Variant) Geometry() *Geometry {
+	return Geometries[variant.BoardDim]
+}
+
+func (variant *Variant) String() string {
+	return variant.Name
+}
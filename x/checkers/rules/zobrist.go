@@ -0,0 +1,87 @@
+package rules
+
+// Zobrist keys are drawn from a fixed seed, so that every node computes the
+// same hash for the same position.
+const zobristSeed uint64 = 0x636865636b657273
+
+var zobristPieces [MAX_BOARD_DIM * MAX_BOARD_DIM][4]uint64
+var zobristCapturing [MAX_BOARD_DIM * MAX_BOARD_DIM]uint64
+var zobristRedTurn uint64
+var zobristCaptured [MAX_BOARD_DIM * MAX_BOARD_DIM]uint64
+
+func init() {
+	state := zobristSeed
+	for square := range zobristPieces {
+		for kind := range zobristPieces[square] {
+			zobristPieces[square][kind] = splitMix64(&state)
+		}
+		zobristCapturing[square] = splitMix64(&state)
+	}
+	zobristRedTurn = splitMix64(&state)
+	for square := range zobristCaptured {
+		zobristCaptured[square] = splitMix64(&state)
+	}
+}
+
+func splitMix64(state *uint64) uint64 {
+	*state += 0x9e3779b97f4a7c15
+	z := *state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+func zobristPiece(pos Pos, piece Piece) uint64 {
+	kind := 0
+	if piece.Player == RED_PLAYER {
+		kind = 2
+	}
+	if piece.King {
+		kind++
+	}
+	return zobristPieces[pos.Y*MAX_BOARD_DIM+pos.X][kind]
+}
+
+// ComputeHash returns the Zobrist hash of the pieces from scratch.
+func (game *Game) ComputeHash() uint64 {
+	var hash uint64
+	for pos, piece := range game.Pieces {
+		hash ^= zobristPiece(pos, piece)
+	}
+	return hash
+}
+
+// Zobrist returns the hash of the whole position: pieces, turn, the piece in
+// the middle of a capture sequence and those it has captured so far.
+func (game *Game) Zobrist() uint64 {
+	hash := game.Hash
+	if game.Turn == RED_PLAYER {
+		hash ^= zobristRedTurn
+	}
+	if game.Capturing != NO_POS {
+		hash ^= zobristCapturing[game.Capturing.Y*MAX_BOARD_DIM+game.Capturing.X]
+	}
+	for _, pos := range game.Captured {
+		hash ^= zobristCaptured[pos.Y*MAX_BOARD_DIM+pos.X]
+	}
+	return hash
+}
+
+func (game *Game) setPiece(pos Pos, piece Piece) {
+	game.removePiece(pos)
+	game.Pieces[pos] = piece
+	game.Hash ^= zobristPiece(pos, piece)
+	if game.Bitboard != nil {
+		game.Bitboard.set(pos, piece)
+	}
+}
+
+func (game *Game) removePiece(pos Pos) {
+	if piece, ok := game.Pieces[pos]; ok {
+		game.Hash ^= zobristPiece(pos, piece)
+		delete(game.Pieces, pos)
+		if game.Bitboard != nil {
+			game.Bitboard.clear(pos)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package rules_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func playRandomly(t *testing.T, game *rules.Game, seed int64, plies int) {
+	random := rand.New(rand.NewSource(seed))
+	for i := 0; i < plies; i++ {
+		steps := game.ValidSteps()
+		if len(steps) == 0 {
+			return
+		}
+		step := steps[random.Intn(len(steps))]
+		_, err := game.Move(step.Src, step.Dst)
+		require.Nil(t, err)
+		require.Equal(t, game.ComputeHash(), game.Hash, "ply %d", i)
+		require.Equal(t, game.ComputeBitboard(), game.Bitboard, "ply %d", i)
+	}
+}
+
+func TestZobristIncrementalMatchesFull(t *testing.T) {
+	for name, variant := range rules.Variants {
+		for seed := int64(0); seed < 20; seed++ {
+			game, err := rules.NewGame(variant)
+			require.Nil(t, err)
+			playRandomly(t, game, seed, 200)
+			reparsed, err := rules.ParseVariant(game.String(), variant)
+			require.Nil(t, err, name)
+			require.Equal(t, game.Hash, reparsed.Hash, name)
+		}
+	}
+}
+
+func TestZobristTransposition(t *testing.T) {
+	first := rules.New()
+	second := rules.New()
+	for _, move := range [][2]rules.Pos{{{1, 2}, {0, 3}}, {{6, 5}, {7, 4}}, {{5, 2}, {4, 3}}} {
+		_, err := first.Move(move[0], move[1])
+		require.Nil(t, err)
+	}
+	for _, move := range [][2]rules.Pos{{{5, 2}, {4, 3}}, {{6, 5}, {7, 4}}, {{1, 2}, {0, 3}}} {
+		_, err := second.Move(move[0], move[1])
+		require.Nil(t, err)
+	}
+	require.Equal(t, first.String(), second.String())
+	require.Equal(t, first.Zobrist(), second.Zobrist())
+}
+
+func TestZobristDependsOnTurnAndCapture(t *testing.T) {
+	game := rules.New()
+	black := game.Zobrist()
+	game.Turn = rules.RED_PLAYER
+	require.NotEqual(t, black, game.Zobrist())
+	red := game.Zobrist()
+	game.Capturing = rules.Pos{X: 1, Y: 2}
+	require.NotEqual(t, red, game.Zobrist())
+	require.NotEqual(t, black, game.Zobrist())
+}
+
+func TestZobristIsStable(t *testing.T) {
+	require.Equal(t, rules.New().Zobrist(), rules.New().Zobrist())
+	international, err := rules.NewGame(rules.INTERNATIONAL_VARIANT)
+	require.Nil(t, err)
+	require.NotEqual(t, rules.New().Zobrist(), international.Zobrist())
+}
@@ -0,0 +1,67 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/LeTrongDat/checkers/x/checkers/keeper"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// SimulateMsgAcceptInvite has an invited player accept one of the pending
+// games.
+func SimulateMsgAcceptInvite(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		systemInfo, found := k.GetSystemInfo(ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAcceptInvite, "system info not found"), nil, nil
+		}
+		type invitation struct {
+			gameIndex string
+			player    string
+		}
+		invitations := []invitation{}
+		for _, storedGame := range queueGames(ctx, k, systemInfo.InviteHeadIndex) {
+			if storedGame.AwaitingBlack {
+				invitations = append(invitations, invitation{storedGame.Index, storedGame.Black})
+			}
+			if storedGame.AwaitingRed {
+				invitations = append(invitations, invitation{storedGame.Index, storedGame.Red})
+			}
+		}
+		if len(invitations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAcceptInvite, "no invitation to accept"), nil, nil
+		}
+		chosen := invitations[r.Intn(len(invitations))]
+		simAccount, found := FindAccount(accs, chosen.player)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAcceptInvite, "player is not a simulation account"), nil, nil
+		}
+		msg := types.NewMsgAcceptInvite(chosen.player, chosen.gameIndex)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -6,10 +6,14 @@ import (
 	"github.com/LeTrongDat/checkers/x/checkers/keeper"
 	"github.com/LeTrongDat/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
+// SimulateMsgCreateGame creates a game between two different accounts, one of
+// which sends it so that it passes whatever the params are.
 func SimulateMsgCreateGame(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -17,13 +21,36 @@ func SimulateMsgCreateGame(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgCreateGame{
-			Creator: simAccount.Address.String(),
+		if len(accs) < 2 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateGame, "not enough accounts"), nil, nil
 		}
+		black, red := randomPlayers(r, accs)
+		simAccount := black
+		if r.Intn(2) == 0 {
+			simAccount = red
+		}
+		msg := types.NewMsgCreateGame(
+			simAccount.Address.String(),
+			black.Address.String(),
+			red.Address.String(),
+			randomWager(r),
+			randomVariant(r).Name,
+		)
 
-		// TODO: Handling the CreateGame simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "CreateGame simulation not implemented"), nil, nil
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
@@ -0,0 +1,84 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Simulation parameter constants
+const (
+	AllowSelfPlay     = "allow_self_play"
+	CreatorMustPlay   = "creator_must_play"
+	RequireAcceptance = "require_acceptance"
+	StoredGameCount   = "stored_game_count"
+)
+
+// RandomizedParams generates the params of the genesis, unless the app params
+// of the simulation set them.
+func RandomizedParams(simState *module.SimulationState) types.Params {
+	var allowSelfPlay, creatorMustPlay, requireAcceptance bool
+	simState.AppParams.GetOrGenerate(simState.Cdc, AllowSelfPlay, &allowSelfPlay, simState.Rand,
+		func(r *rand.Rand) { allowSelfPlay = r.Intn(2) == 0 },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, CreatorMustPlay, &creatorMustPlay, simState.Rand,
+		func(r *rand.Rand) { creatorMustPlay = r.Intn(2) == 0 },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, RequireAcceptance, &requireAcceptance, simState.Rand,
+		func(r *rand.Rand) { requireAcceptance = r.Intn(2) == 0 },
+	)
+	return types.NewParams(allowSelfPlay, creatorMustPlay, requireAcceptance)
+}
+
+// RandomizedStoredGames creates games between the simulation accounts, in the
+// deadline FIFO or, when they require acceptance, in the invites list. Nobody
+// has played in them yet, so the bank genesis has nothing to escrow for them.
+func RandomizedStoredGames(simState *module.SimulationState, requireAcceptance bool) (types.SystemInfo, []types.StoredGame) {
+	var count int
+	simState.AppParams.GetOrGenerate(simState.Cdc, StoredGameCount, &count, simState.Rand,
+		func(r *rand.Rand) { count = r.Intn(20) },
+	)
+	systemInfo := *types.DefaultGenesis().SystemInfo
+	storedGames := make([]types.StoredGame, 0, count)
+	if len(simState.Accounts) < 2 {
+		return systemInfo, storedGames
+	}
+
+	for i := 0; i < count; i++ {
+		black, red := randomPlayers(simState.Rand, simState.Accounts)
+		variant := randomVariant(simState.Rand)
+		newGame, err := rules.NewGame(variant)
+		if err != nil {
+			panic(err)
+		}
+		storedGame := types.StoredGame{
+			Index:       strconv.FormatUint(systemInfo.NextId, 10),
+			Board:       newGame.String(),
+			Turn:        rules.PieceStrings[newGame.Turn],
+			Black:       black.Address.String(),
+			Red:         red.Address.String(),
+			BeforeIndex: types.NoFifoIndex,
+			AfterIndex:  types.NoFifoIndex,
+			Deadline:    types.FormatDeadline(simState.GenTimestamp.Add(types.MaxTurnDuration)),
+			Winner:      rules.PieceStrings[rules.NO_PLAYER],
+			Wager:       randomWager(simState.Rand),
+			Variant:     variant.Name,
+			Hash:        newGame.Zobrist(),
+		}
+		if requireAcceptance {
+			// The player who created it has accepted already
+			storedGame.AwaitingBlack = simState.Rand.Intn(2) == 0
+			storedGame.AwaitingRed = !storedGame.AwaitingBlack
+			storedGame.Deadline = types.FormatDeadline(simState.GenTimestamp.Add(types.MaxInviteDuration))
+		}
+		storedGames = append(storedGames, storedGame)
+		systemInfo.NextId++
+	}
+	if err := types.RebuildFifo(&systemInfo, storedGames); err != nil {
+		panic(err)
+	}
+	return systemInfo, storedGames
+}
@@ -3,13 +3,20 @@ package simulation
 import (
 	"math/rand"
 
+	"github.com/LeTrongDat/checkers/x/checkers/engine"
 	"github.com/LeTrongDat/checkers/x/checkers/keeper"
 	"github.com/LeTrongDat/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
+// SimulateMsgPlayMove plays a random legal move in one of the games of the
+// deadline FIFO. The simulated blocks are further apart than the turn
+// duration, so the games left in the FIFO are forfeited at the end of the
+// next block.
 func SimulateMsgPlayMove(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -17,13 +24,55 @@ func SimulateMsgPlayMove(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgPlayMove{
-			Creator: simAccount.Address.String(),
+		systemInfo, found := k.GetSystemInfo(ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "system info not found"), nil, nil
 		}
+		storedGames := queueGames(ctx, k, systemInfo.FifoHeadIndex)
+		if len(storedGames) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "no game to play"), nil, nil
+		}
+		storedGame := storedGames[r.Intn(len(storedGames))]
+		player, found, err := storedGame.GetPlayerAddress(storedGame.Turn)
+		if err != nil || !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "player not found"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, player)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "player is not a simulation account"), nil, nil
+		}
+		game, err := storedGame.ParseGame()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "game cannot be parsed"), nil, err
+		}
+		moves := engine.Moves(game)
+		if len(moves) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "no legal move"), nil, nil
+		}
+		move := moves[r.Intn(len(moves))]
+		msg := types.NewMsgPlayMoveNotation(simAccount.Address.String(), storedGame.Index, move.Notation(game.Variant))
 
-		// TODO: Handling the PlayMove simulation
+		spent := sdk.NewCoins()
+		if (storedGame.MoveCount == 0 && storedGame.BlackDeposit == 0) ||
+			(storedGame.MoveCount == 1 && storedGame.RedDeposit == 0) {
+			// The wager is collected on the first move of each player
+			spent = sdk.NewCoins(storedGame.GetWagerCoin())
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "PlayMove simulation not implemented"), nil, nil
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spent,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
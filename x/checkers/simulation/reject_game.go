@@ -6,10 +6,14 @@ import (
 	"github.com/LeTrongDat/checkers/x/checkers/keeper"
 	"github.com/LeTrongDat/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
+// SimulateMsgRejectGame has a player reject a game before their first move,
+// among the ongoing games and the pending invitations.
 func SimulateMsgRejectGame(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -17,13 +21,51 @@ func SimulateMsgRejectGame(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgRejectGame{
-			Creator: simAccount.Address.String(),
+		systemInfo, found := k.GetSystemInfo(ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRejectGame, "system info not found"), nil, nil
 		}
+		type rejection struct {
+			gameIndex string
+			player    string
+		}
+		rejections := []rejection{}
+		storedGames := append(
+			queueGames(ctx, k, systemInfo.FifoHeadIndex),
+			queueGames(ctx, k, systemInfo.InviteHeadIndex)...)
+		for _, storedGame := range storedGames {
+			if storedGame.MoveCount == 0 {
+				rejections = append(rejections, rejection{storedGame.Index, storedGame.Black})
+			}
+			// With self-play, the player is taken as black first
+			if storedGame.MoveCount <= 1 && storedGame.Red != storedGame.Black {
+				rejections = append(rejections, rejection{storedGame.Index, storedGame.Red})
+			}
+		}
+		if len(rejections) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRejectGame, "no game to reject"), nil, nil
+		}
+		chosen := rejections[r.Intn(len(rejections))]
+		simAccount, found := FindAccount(accs, chosen.player)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRejectGame, "player is not a simulation account"), nil, nil
+		}
+		msg := types.NewMsgRejectGame(chosen.player, chosen.gameIndex)
 
-		// TODO: Handling the RejectGame simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "RejectGame simulation not implemented"), nil, nil
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
@@ -1,10 +1,19 @@
 package simulation
 
 import (
+	"math/rand"
+	"sort"
+
+	"github.com/LeTrongDat/checkers/x/checkers/keeper"
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	"github.com/LeTrongDat/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
+// maxWager keeps the wagers well within the balances of the simulation accounts
+const maxWager = 1_000_000
+
 // FindAccount find a specific address from an account list
 func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
 	creator, err := sdk.AccAddressFromBech32(address)
@@ -13,3 +22,44 @@ func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, boo
 	}
 	return simtypes.FindAccount(accs, creator)
 }
+
+// randomPlayers picks two different accounts to play black and red.
+func randomPlayers(r *rand.Rand, accs []simtypes.Account) (black simtypes.Account, red simtypes.Account) {
+	blackIndex := r.Intn(len(accs))
+	redIndex := r.Intn(len(accs) - 1)
+	if blackIndex <= redIndex {
+		redIndex++
+	}
+	return accs[blackIndex], accs[redIndex]
+}
+
+// randomVariant picks one of the variants the engine can play. The names are
+// sorted so that a seed always gives the same simulation.
+func randomVariant(r *rand.Rand) *rules.Variant {
+	names := make([]string, 0, len(rules.Variants))
+	for name, variant := range rules.Variants {
+		if variant.Validate() == nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return rules.Variants[names[r.Intn(len(names))]]
+}
+
+func randomWager(r *rand.Rand) uint64 {
+	return uint64(r.Int63n(maxWager))
+}
+
+// queueGames returns the games of the list that starts at head, in order.
+func queueGames(ctx sdk.Context, k keeper.Keeper, head string) []types.StoredGame {
+	games := []types.StoredGame{}
+	for index := head; index != types.NoFifoIndex; {
+		storedGame, found := k.GetStoredGame(ctx, index)
+		if !found {
+			panic("Game in queue not found " + index)
+		}
+		games = append(games, storedGame)
+		index = storedGame.AfterIndex
+	}
+	return games
+}
@@ -11,6 +11,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateGame{}, "checkers/CreateGame", nil)
 	cdc.RegisterConcrete(&MsgPlayMove{}, "checkers/PlayMove", nil)
 	cdc.RegisterConcrete(&MsgRejectGame{}, "checkers/RejectGame", nil)
+	cdc.RegisterConcrete(&MsgAcceptInvite{}, "checkers/AcceptInvite", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -24,6 +25,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRejectGame{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptInvite{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateGameParams is what it takes to create a game, whether it comes from a
+// MsgCreateGame or from another module calling the keeper.
+type CreateGameParams struct {
+	Creator string
+	Black   string
+	Red     string
+	Wager   uint64
+	Variant string
+}
+
+// Validate checks what can be checked without the store.
+func (params CreateGameParams) Validate() error {
+	_, err := sdk.AccAddressFromBech32(params.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(params.Black)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBlack, "%s: %s", params.Black, err)
+	}
+	_, err = sdk.AccAddressFromBech32(params.Red)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRed, "%s: %s", params.Red, err)
+	}
+	variant, err := rules.VariantByName(params.Variant)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidVariant, "%s: %s", params.Variant, err)
+	}
+	if err = variant.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidVariant, "%s: %s", params.Variant, err)
+	}
+	return nil
+}
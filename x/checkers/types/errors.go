@@ -8,8 +8,8 @@ import (
 
 // x/checkers module sentinel errors
 var (
-	ErrInvalidBlack            = sdkerrors.Register(ModuleName, 1100, "black address is invalid: %s")
-	ErrInvalidRed              = sdkerrors.Register(ModuleName, 1101, "red address is invalid: %s")
+	ErrInvalidBlack            = sdkerrors.Register(ModuleName, 1100, "black address is invalid")
+	ErrInvalidRed              = sdkerrors.Register(ModuleName, 1101, "red address is invalid")
 	ErrGameNotParseable        = sdkerrors.Register(ModuleName, 1102, "game cannot be parsed")
 	ErrGameNotFound            = sdkerrors.Register(ModuleName, 1103, "game by id not found")
 	ErrCreatorNotPlayer        = sdkerrors.Register(ModuleName, 1104, "message creator is not a player")
@@ -24,6 +24,15 @@ var (
 	ErrRedCannotPay            = sdkerrors.Register(ModuleName, 1113, "red cannot pay the wager")
 	ErrNothingToPay            = sdkerrors.Register(ModuleName, 1114, "there is nothing to pay, should not have been called")
 	ErrCannotRefundWager       = sdkerrors.Register(ModuleName, 1115, "cannot refund wager to: %s")
-	ErrCannotPayWinnings       = sdkerrors.Register(ModuleName, 1116, "cannot pay winning to winner: %s")
+	ErrCannotPayWinnings       = sdkerrors.Register(ModuleName, 1116, "cannot pay winnings to winner: %s")
 	ErrNotInRefundState        = sdkerrors.Register(ModuleName, 1117, "game is not in a state to refund, move count: %d")
+	ErrInvalidVariant          = sdkerrors.Register(ModuleName, 1118, "variant is invalid")
+	ErrInvalidMoveNotation     = sdkerrors.Register(ModuleName, 1119, "move notation is invalid")
+	ErrSelfPlay                = sdkerrors.Register(ModuleName, 1120, "black and red are the same player")
+	ErrCreatorNotInGame        = sdkerrors.Register(ModuleName, 1121, "game creator plays neither black nor red")
+	ErrGamePending             = sdkerrors.Register(ModuleName, 1122, "game is waiting for its players to accept")
+	ErrNothingToAccept         = sdkerrors.Register(ModuleName, 1123, "player has no invitation to accept")
+	ErrInvalidWinner           = sdkerrors.Register(ModuleName, 1124, "winner is invalid")
+	ErrWinnerNotOnBoard        = sdkerrors.Register(ModuleName, 1125, "winner does not match the board")
+	ErrInvalidEndReason        = sdkerrors.Register(ModuleName, 1126, "reason to end the game is invalid")
 )
@@ -20,4 +20,16 @@ type BankKeeper interface {
 type BankEscrowKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// CheckersHooks event hooks for other modules to follow the games, for instance
+// to give rewards or update ratings. They are called once the state is saved.
+type CheckersHooks interface {
+	AfterGameCreated(ctx sdk.Context, storedGame StoredGame)
+	// Called once per message, after all the steps of the move are played
+	AfterMovePlayed(ctx sdk.Context, storedGame StoredGame, player sdk.AccAddress)
+	// Called with nil winner and loser when the game is cancelled and the
+	// deposits are refunded
+	AfterGameEnded(ctx sdk.Context, storedGame StoredGame, winner sdk.AccAddress, loser sdk.AccAddress, reason string)
 }
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	FifoListName    = "fifo"
+	InvitesListName = "invites"
+)
+
+// IsInFifo tells whether the game belongs in the deadline FIFO, i.e. whether
+// it is being played.
+func (storedGame StoredGame) IsInFifo() bool {
+	return storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] && !storedGame.IsPending()
+}
+
+// IsInInvites tells whether the game belongs in the list of pending invitations.
+func (storedGame StoredGame) IsInInvites() bool {
+	return storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] && storedGame.IsPending()
+}
+
+// FifoProblems walks the deadline FIFO and the list of pending invitations
+// across the games, which are by index, and describes every inconsistency.
+func FifoProblems(systemInfo SystemInfo, games map[string]StoredGame) []string {
+	fifo, problems := walkList(FifoListName, systemInfo.FifoHeadIndex, systemInfo.FifoTailIndex, games, StoredGame.IsInFifo)
+	invites, invitesProblems := walkList(InvitesListName, systemInfo.InviteHeadIndex, systemInfo.InviteTailIndex, games, StoredGame.IsInInvites)
+	problems = append(problems, invitesProblems...)
+	for _, index := range sortedIndices(games) {
+		game := games[index]
+		if game.IsInFifo() && !fifo[index] {
+			problems = append(problems, fmt.Sprintf("%s: game %s is missing", FifoListName, index))
+		} else if game.IsInInvites() && !invites[index] {
+			problems = append(problems, fmt.Sprintf("%s: game %s is missing", InvitesListName, index))
+		} else if !fifo[index] && !invites[index] && (game.BeforeIndex != NoFifoIndex || game.AfterIndex != NoFifoIndex) {
+			problems = append(problems, fmt.Sprintf("game %s is in no list but links %s and %s", index, game.BeforeIndex, game.AfterIndex))
+		}
+	}
+	return problems
+}
+
+// walkList follows the list from its head, and returns the games it went
+// through along with the problems found on the way.
+func walkList(name string, head string, tail string, games map[string]StoredGame, belongs func(StoredGame) bool) (visited map[string]bool, problems []string) {
+	visited = map[string]bool{}
+	problems = []string{}
+	if (head == NoFifoIndex) != (tail == NoFifoIndex) {
+		problems = append(problems, fmt.Sprintf("%s: head %s and tail %s are not both %s", name, head, tail, NoFifoIndex))
+	}
+	previous := NoFifoIndex
+	var previousDeadline time.Time
+	for index := head; index != NoFifoIndex; {
+		if visited[index] {
+			problems = append(problems, fmt.Sprintf("%s: cycle back to game %s after game %s", name, index, previous))
+			break
+		}
+		game, found := games[index]
+		if !found {
+			problems = append(problems, fmt.Sprintf("%s: game %s not found after game %s", name, index, previous))
+			break
+		}
+		visited[index] = true
+		if game.BeforeIndex != previous {
+			problems = append(problems, fmt.Sprintf("%s: game %s links back to %s instead of %s", name, index, game.BeforeIndex, previous))
+		}
+		if !belongs(game) {
+			problems = append(problems, fmt.Sprintf("%s: game %s does not belong in it", name, index))
+		}
+		deadline, err := game.GetDeadlineAsTime()
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: game %s: %s", name, index, err))
+		} else {
+			if previous != NoFifoIndex && deadline.Before(previousDeadline) {
+				problems = append(problems, fmt.Sprintf("%s: game %s has a deadline before that of game %s", name, index, previous))
+			}
+			previousDeadline = deadline
+		}
+		previous = index
+		index = game.AfterIndex
+	}
+	if previous != tail {
+		problems = append(problems, fmt.Sprintf("%s: tail is %s instead of %s", name, tail, previous))
+	}
+	return visited, problems
+}
+
+// RebuildFifo links again the deadline FIFO and the list of pending
+// invitations from scratch, each sorted by deadline and then by index.
+func RebuildFifo(systemInfo *SystemInfo, games []StoredGame) error {
+	deadlines := make([]time.Time, len(games))
+	order := make([]int, len(games))
+	for i := range games {
+		deadline, err := games[i].GetDeadlineAsTime()
+		if err != nil && (games[i].IsInFifo() || games[i].IsInInvites()) {
+			return sdkerrors.Wrapf(err, "game %s", games[i].Index)
+		}
+		deadlines[i] = deadline
+		order[i] = i
+		games[i].BeforeIndex = NoFifoIndex
+		games[i].AfterIndex = NoFifoIndex
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		if !deadlines[order[a]].Equal(deadlines[order[b]]) {
+			return deadlines[order[a]].Before(deadlines[order[b]])
+		}
+		return indexLess(games[order[a]].Index, games[order[b]].Index)
+	})
+	systemInfo.FifoHeadIndex, systemInfo.FifoTailIndex = linkList(games, order, StoredGame.IsInFifo)
+	systemInfo.InviteHeadIndex, systemInfo.InviteTailIndex = linkList(games, order, StoredGame.IsInInvites)
+	return nil
+}
+
+func linkList(games []StoredGame, order []int, belongs func(StoredGame) bool) (head string, tail string) {
+	head, tail = NoFifoIndex, NoFifoIndex
+	previous := -1
+	for _, i := range order {
+		if !belongs(games[i]) {
+			continue
+		}
+		if previous < 0 {
+			head = games[i].Index
+		} else {
+			games[previous].AfterIndex = games[i].Index
+			games[i].BeforeIndex = games[previous].Index
+		}
+		tail = games[i].Index
+		previous = i
+	}
+	return head, tail
+}
+
+// indexLess orders game indices as numbers when they are.
+func indexLess(a string, b string) bool {
+	numA, errA := strconv.ParseUint(a, 10, 64)
+	numB, errB := strconv.ParseUint(b, 10, 64)
+	if errA == nil && errB == nil {
+		return numA < numB
+	}
+	return a < b
+}
+
+func sortedIndices(games map[string]StoredGame) []string {
+	indices := make([]string, 0, len(games))
+	for index := range games {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(a, b int) bool {
+		return indexLess(indices[a], indices[b])
+	})
+	return indices
+}
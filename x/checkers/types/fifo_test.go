@@ -0,0 +1,168 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/LeTrongDat/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	deadline1 = "2022-06-01 10:00:00 +0000 UTC"
+	deadline2 = "2022-06-01 11:00:00 +0000 UTC"
+)
+
+func getGamesForFifo() []types.StoredGame {
+	return []types.StoredGame{
+		{Index: "1", Winner: "*", Deadline: deadline1, BeforeIndex: "-1", AfterIndex: "3"},
+		{Index: "2", Winner: "b", Deadline: deadline1, BeforeIndex: "-1", AfterIndex: "-1"},
+		{Index: "3", Winner: "*", Deadline: deadline2, BeforeIndex: "1", AfterIndex: "-1"},
+		{Index: "4", Winner: "*", Deadline: deadline1, AwaitingRed: true, BeforeIndex: "-1", AfterIndex: "-1"},
+	}
+}
+
+func getSystemInfoForFifo() types.SystemInfo {
+	return types.SystemInfo{
+		NextId:          5,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "3",
+		InviteHeadIndex: "4",
+		InviteTailIndex: "4",
+	}
+}
+
+func byIndex(games []types.StoredGame) map[string]types.StoredGame {
+	indexed := map[string]types.StoredGame{}
+	for _, game := range games {
+		indexed[game.Index] = game
+	}
+	return indexed
+}
+
+func TestFifoProblemsNone(t *testing.T) {
+	require.Empty(t, types.FifoProblems(getSystemInfoForFifo(), byIndex(getGamesForFifo())))
+}
+
+func TestFifoProblemsEmpty(t *testing.T) {
+	require.Empty(t, types.FifoProblems(types.SystemInfo{
+		NextId:          1,
+		FifoHeadIndex:   "-1",
+		FifoTailIndex:   "-1",
+		InviteHeadIndex: "-1",
+		InviteTailIndex: "-1",
+	}, map[string]types.StoredGame{}))
+}
+
+func TestFifoProblemsBrokenBackLink(t *testing.T) {
+	games := getGamesForFifo()
+	games[2].BeforeIndex = "2"
+	require.EqualValues(t, []string{
+		"fifo: game 3 links back to 2 instead of 1",
+	}, types.FifoProblems(getSystemInfoForFifo(), byIndex(games)))
+}
+
+func TestFifoProblemsCycle(t *testing.T) {
+	games := getGamesForFifo()
+	games[2].AfterIndex = "1"
+	require.EqualValues(t, []string{
+		"fifo: cycle back to game 1 after game 3",
+	}, types.FifoProblems(getSystemInfoForFifo(), byIndex(games)))
+}
+
+func TestFifoProblemsWrongHeadAndTail(t *testing.T) {
+	systemInfo := getSystemInfoForFifo()
+	systemInfo.FifoHeadIndex = "3"
+	systemInfo.FifoTailIndex = "-1"
+	require.EqualValues(t, []string{
+		"fifo: head 3 and tail -1 are not both -1",
+		"fifo: game 3 links back to 1 instead of -1",
+		"fifo: tail is -1 instead of 3",
+		"fifo: game 1 is missing",
+	}, types.FifoProblems(systemInfo, byIndex(getGamesForFifo())))
+}
+
+func TestFifoProblemsFinishedGameInFifo(t *testing.T) {
+	games := getGamesForFifo()
+	games[2].Winner = "r"
+	require.EqualValues(t, []string{
+		"fifo: game 3 does not belong in it",
+	}, types.FifoProblems(getSystemInfoForFifo(), byIndex(games)))
+}
+
+func TestFifoProblemsPendingGameInFifo(t *testing.T) {
+	games := getGamesForFifo()
+	games[3].AwaitingRed = false
+	require.EqualValues(t, []string{
+		"invites: game 4 does not belong in it",
+		"fifo: game 4 is missing",
+	}, types.FifoProblems(getSystemInfoForFifo(), byIndex(games)))
+}
+
+func TestFifoProblemsDeadlinesOutOfOrder(t *testing.T) {
+	games := getGamesForFifo()
+	games[0].Deadline = deadline2
+	games[2].Deadline = deadline1
+	require.EqualValues(t, []string{
+		"fifo: game 3 has a deadline before that of game 1",
+	}, types.FifoProblems(getSystemInfoForFifo(), byIndex(games)))
+}
+
+func TestFifoProblemsMissingGame(t *testing.T) {
+	games := getGamesForFifo()
+	games[0].AfterIndex = "7"
+	require.EqualValues(t, []string{
+		"fifo: game 7 not found after game 1",
+		"fifo: tail is 3 instead of 1",
+		"fifo: game 3 is missing",
+	}, types.FifoProblems(getSystemInfoForFifo(), byIndex(games)))
+}
+
+func TestFifoProblemsLinkedFinishedGame(t *testing.T) {
+	games := getGamesForFifo()
+	games[1].AfterIndex = "3"
+	require.EqualValues(t, []string{
+		"game 2 is in no list but links -1 and 3",
+	}, types.FifoProblems(getSystemInfoForFifo(), byIndex(games)))
+}
+
+func TestRebuildFifo(t *testing.T) {
+	games := getGamesForFifo()
+	games[0].Deadline = deadline2
+	games[0].BeforeIndex = "3"
+	games[1].AfterIndex = "1"
+	games = append(games, types.StoredGame{Index: "10", Winner: "*", Deadline: deadline2, BeforeIndex: "9", AfterIndex: "-1"})
+	systemInfo := types.SystemInfo{NextId: 11, FifoHeadIndex: "2", FifoTailIndex: "-1", InviteHeadIndex: "-1", InviteTailIndex: "-1"}
+
+	require.Nil(t, types.RebuildFifo(&systemInfo, games))
+	require.EqualValues(t, types.SystemInfo{
+		NextId:          11,
+		FifoHeadIndex:   "1",
+		FifoTailIndex:   "10",
+		InviteHeadIndex: "4",
+		InviteTailIndex: "4",
+	}, systemInfo)
+	require.EqualValues(t, [][2]string{
+		{"-1", "3"},
+		{"-1", "-1"},
+		{"1", "10"},
+		{"-1", "-1"},
+		{"3", "-1"},
+	}, links(games))
+	require.Empty(t, types.FifoProblems(systemInfo, byIndex(games)))
+}
+
+func TestRebuildFifoBadDeadline(t *testing.T) {
+	games := getGamesForFifo()
+	games[2].Deadline = "tomorrow"
+	systemInfo := getSystemInfoForFifo()
+	err := types.RebuildFifo(&systemInfo, games)
+	require.EqualError(t, err, "game 3: deadline can not be parsed: tomorrow: parsing time \"tomorrow\" as \"2006-01-02 15:04:05.999999999 +0000 UTC\": cannot parse \"tomorrow\" as \"2006\"")
+}
+
+func links(games []types.StoredGame) [][2]string {
+	result := [][2]string{}
+	for _, game := range games {
+		result = append(result, [2]string{game.BeforeIndex, game.AfterIndex})
+	}
+	return result
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/LeTrongDat/checkers/x/checkers/rules"
@@ -12,16 +14,34 @@ import (
 
 func (storedGame StoredGame) GetBlackAddress() (black sdk.AccAddress, err error) {
 	black, errBlack := sdk.AccAddressFromBech32(storedGame.Black)
-	return black, sdkerrors.Wrapf(errBlack, ErrInvalidBlack.Error(), storedGame.Black)
+	if errBlack != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidBlack, "%s: %s", storedGame.Black, errBlack)
+	}
+	return black, nil
 }
 
 func (storedGame StoredGame) GetRedAddress() (red sdk.AccAddress, err error) {
 	red, errRed := sdk.AccAddressFromBech32(storedGame.Red)
-	return red, sdkerrors.Wrapf(errRed, ErrInvalidRed.Error(), storedGame.Red)
+	if errRed != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidRed, "%s: %s", storedGame.Red, errRed)
+	}
+	return red, nil
+}
+
+func (storedGame StoredGame) GetRulesVariant() (variant *rules.Variant, err error) {
+	variant, errVariant := rules.VariantByName(storedGame.Variant)
+	if errVariant != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidVariant, "%s: %s", storedGame.Variant, errVariant)
+	}
+	return variant, nil
 }
 
 func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
-	game, errGame := rules.Parse(storedGame.Board)
+	variant, err := storedGame.GetRulesVariant()
+	if err != nil {
+		return nil, err
+	}
+	game, errGame := rules.ParseVariant(storedGame.Board, variant)
 	if errGame != nil {
 		return nil, sdkerrors.Wrapf(errGame, ErrGameNotParseable.Error())
 	}
@@ -29,6 +49,19 @@ func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
 	if game.Turn.Color == "NO_PLAYER" {
 		return nil, sdkerrors.Wrapf(errors.New(fmt.Sprintf("Turn: %s", storedGame.Turn)), ErrGameNotParseable.Error())
 	}
+	game.Capturing, errGame = rules.ParsePos(storedGame.Capturing)
+	if errGame != nil {
+		return nil, sdkerrors.Wrapf(errGame, ErrGameNotParseable.Error())
+	}
+	for _, captured := range storedGame.Captured {
+		pos, errPos := rules.ParsePos(captured)
+		if errPos != nil || !game.PieceAt(pos) {
+			return nil, sdkerrors.Wrapf(ErrGameNotParseable, "captured: %s", captured)
+		}
+		game.Captured = append(game.Captured, pos)
+	}
+	// captured pieces are left out of the bitboard
+	game.Bitboard = game.ComputeBitboard()
 	return game, nil
 }
 
@@ -41,14 +74,34 @@ func (storedGame StoredGame) Validate() (err error) {
 		return err
 	}
 
-	if _, err = storedGame.ParseGame(); err != nil {
-		return err
+	switch storedGame.Winner {
+	case rules.PieceStrings[rules.NO_PLAYER]:
+		game, err := storedGame.ParseGame()
+		if err != nil {
+			return err
+		}
+		if winner := game.Winner(); winner != rules.NO_PLAYER {
+			return sdkerrors.Wrapf(ErrWinnerNotOnBoard, "board won by %s", rules.PieceStrings[winner])
+		}
+	case rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]:
+		// The board of a finished game is cleared
+		if storedGame.Board != "" {
+			return sdkerrors.Wrapf(ErrWinnerNotOnBoard, "board not cleared after %s won", storedGame.Winner)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidWinner, "%s", storedGame.Winner)
 	}
 
 	_, err = storedGame.GetDeadlineAsTime()
 	return err
 }
 
+// IsPending tells whether the game still waits for an invited player to
+// accept it. Its deadline is then the expiry of the invitation.
+func (storedGame StoredGame) IsPending() bool {
+	return storedGame.AwaitingBlack || storedGame.AwaitingRed
+}
+
 func (storedGame StoredGame) GetDeadlineAsTime() (deadline time.Time, err error) {
 	deadline, errDeadline := time.Parse(DeadlineLayout, storedGame.Deadline)
 	return deadline, sdkerrors.Wrapf(errDeadline, ErrInvalidDeadline.Error(), storedGame.Deadline)
@@ -58,6 +111,10 @@ func GetNextDeadline(ctx sdk.Context) time.Time {
 	return ctx.BlockTime().Add(MaxTurnDuration)
 }
 
+func GetInviteDeadline(ctx sdk.Context) time.Time {
+	return ctx.BlockTime().Add(MaxInviteDuration)
+}
+
 func FormatDeadline(deadline time.Time) string {
 	return deadline.UTC().Format(DeadlineLayout)
 }
@@ -78,10 +135,83 @@ func (storedGame StoredGame) GetPlayerAddress(color string) (address sdk.AccAddr
 	return address, found, nil
 }
 
+// HasTurn tells whether the player, given by address, is the one to move.
+func (storedGame StoredGame) HasTurn(player string) bool {
+	switch storedGame.Turn {
+	case rules.PieceStrings[rules.BLACK_PLAYER]:
+		return storedGame.Black == player
+	case rules.PieceStrings[rules.RED_PLAYER]:
+		return storedGame.Red == player
+	}
+	return false
+}
+
 func (storedGame StoredGame) GetWinnerAddress() (address sdk.AccAddress, found bool, err error) {
 	return storedGame.GetPlayerAddress(storedGame.Winner)
 }
 
 func (storedGame StoredGame) GetWagerCoin() (wager sdk.Coin) {
-	return sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(storedGame.Wager)))
+	return GetStakeCoin(storedGame.Wager)
+}
+
+// GetEscrowed returns what the players have deposited and not been paid back yet.
+func (storedGame StoredGame) GetEscrowed() uint64 {
+	return storedGame.BlackDeposit + storedGame.RedDeposit
+}
+
+func (storedGame StoredGame) GetEscrowedCoin() (escrowed sdk.Coin) {
+	return GetStakeCoin(storedGame.GetEscrowed())
+}
+
+func GetStakeCoin(amount uint64) sdk.Coin {
+	return sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntFromUint64(amount))
+}
+
+// Render draws the game for a terminal, with the time left to play as of now.
+func (storedGame StoredGame) Render(now time.Time) (string, error) {
+	variant, err := storedGame.GetRulesVariant()
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Game %s, %s rules, %d moves played\n", storedGame.Index, variant, storedGame.MoveCount)
+	finished := storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER]
+	if !finished {
+		game, err := storedGame.ParseGame()
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(rules.Render(game))
+		buf.WriteString("    b r: men, B R: kings\n")
+	}
+	fmt.Fprintf(&buf, "Black:    %s\n", storedGame.Black)
+	fmt.Fprintf(&buf, "Red:      %s\n", storedGame.Red)
+	fmt.Fprintf(&buf, "Wager:    %s\n", storedGame.GetWagerCoin())
+	if finished {
+		fmt.Fprintf(&buf, "Winner:   %s\n", rules.StringPieces[storedGame.Winner].Player.Name())
+		return buf.String(), nil
+	}
+	if storedGame.IsPending() {
+		waiting := []string{}
+		if storedGame.AwaitingBlack {
+			waiting = append(waiting, rules.BLACK_PLAYER.Name())
+		}
+		if storedGame.AwaitingRed {
+			waiting = append(waiting, rules.RED_PLAYER.Name())
+		}
+		fmt.Fprintf(&buf, "Invited:  %s, yet to accept\n", strings.Join(waiting, " and "))
+	} else {
+		fmt.Fprintf(&buf, "To move:  %s\n", rules.StringPieces[storedGame.Turn].Player.Name())
+	}
+	deadline, err := storedGame.GetDeadlineAsTime()
+	if err != nil {
+		return "", err
+	}
+	left := deadline.Sub(now).Round(time.Second)
+	if left > 0 {
+		fmt.Fprintf(&buf, "Deadline: %s (%s left)\n", storedGame.Deadline, left)
+	} else {
+		fmt.Fprintf(&buf, "Deadline: %s (expired %s ago)\n", storedGame.Deadline, -left)
+	}
+	return buf.String(), nil
 }
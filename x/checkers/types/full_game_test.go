@@ -3,6 +3,7 @@ package types_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/LeTrongDat/checkers/x/checkers/rules"
 	"github.com/LeTrongDat/checkers/x/checkers/types"
@@ -17,11 +18,13 @@ const (
 
 func GetStoredGame1() *types.StoredGame {
 	return &types.StoredGame{
-		Black: alice,
-		Red:   bob,
-		Board: rules.New().String(),
-		Index: "1",
-		Turn:  "b",
+		Black:    alice,
+		Red:      bob,
+		Board:    rules.New().String(),
+		Index:    "1",
+		Turn:     "b",
+		Winner:   "*",
+		Deadline: "2022-06-01 10:00:00 +0000 UTC",
 	}
 }
 
@@ -38,9 +41,10 @@ func TestGetAddressWrongBlack(t *testing.T) {
 	storedGame.Black = "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d4"
 	black, err := storedGame.GetBlackAddress()
 	require.Nil(t, black)
+	require.ErrorIs(t, err, types.ErrInvalidBlack)
 	require.EqualError(t,
 		err,
-		"black address is invalid: cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d4: decoding bech32 failed: invalid checksum (expected 3xn9d3 got 3xn9d4)")
+		"cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d4: decoding bech32 failed: invalid checksum (expected 3xn9d3 got 3xn9d4): black address is invalid")
 	require.EqualError(t, storedGame.Validate(), err.Error())
 }
 
@@ -57,9 +61,10 @@ func TestGetAddressWrongRed(t *testing.T) {
 	storedGame.Red = "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d4"
 	red, err := storedGame.GetRedAddress()
 	require.Nil(t, red)
+	require.ErrorIs(t, err, types.ErrInvalidRed)
 	require.EqualError(t,
 		err,
-		"red address is invalid: cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d4: decoding bech32 failed: invalid checksum (expected 3xn9d3 got 3xn9d4)")
+		"cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d4: decoding bech32 failed: invalid checksum (expected 3xn9d3 got 3xn9d4): red address is invalid")
 	require.EqualError(t, storedGame.Validate(), err.Error())
 }
 
@@ -96,7 +101,142 @@ func TestParseGameWrongTurnColor(t *testing.T) {
 	require.EqualError(t, err, storedGame.Validate().Error())
 }
 
+func TestParseGameCaptured(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Captured = []string{"1,0", "3,2"}
+	game, err := storedGame.ParseGame()
+	require.Nil(t, err)
+	require.Equal(t, []rules.Pos{{X: 1, Y: 0}, {X: 3, Y: 2}}, game.Captured)
+	require.True(t, game.IsCaptured(rules.Pos{X: 3, Y: 2}))
+}
+
+func TestParseGameCapturedNoPiece(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Captured = []string{"0,3"}
+	game, err := storedGame.ParseGame()
+	require.Nil(t, game)
+	require.ErrorIs(t, err, types.ErrGameNotParseable)
+	require.EqualError(t, err, "captured: 0,3: game cannot be parsed")
+}
+
+func TestHasTurn(t *testing.T) {
+	storedGame := GetStoredGame1()
+	require.True(t, storedGame.HasTurn(alice))
+	require.False(t, storedGame.HasTurn(bob))
+	storedGame.Turn = "r"
+	require.False(t, storedGame.HasTurn(alice))
+	require.True(t, storedGame.HasTurn(bob))
+	storedGame.Turn = "*"
+	require.False(t, storedGame.HasTurn(alice))
+	require.False(t, storedGame.HasTurn(bob))
+}
+
 func TestGameValidateOk(t *testing.T) {
 	storedGame := GetStoredGame1()
 	require.NoError(t, storedGame.Validate())
 }
+
+func TestGameValidateFinishedOk(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "r"
+	storedGame.Board = ""
+	require.NoError(t, storedGame.Validate())
+}
+
+func TestGameValidateWrongWinner(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "x"
+	require.EqualError(t, storedGame.Validate(), "x: winner is invalid")
+}
+
+func TestGameValidateFinishedWithBoard(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "b"
+	require.EqualError(t, storedGame.Validate(), "board not cleared after b won: winner does not match the board")
+}
+
+func TestGameValidateOngoingButWon(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Board = strings.ReplaceAll(storedGame.Board, "r", "*")
+	require.EqualError(t, storedGame.Validate(), "board won by b: winner does not match the board")
+}
+
+func TestGameValidateWrongDeadline(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Deadline = "tomorrow"
+	require.ErrorContains(t, storedGame.Validate(), "deadline can not be parsed: tomorrow: ")
+}
+
+func TestRenderOngoingGame(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "*"
+	storedGame.Wager = 45
+	storedGame.MoveCount = 2
+	storedGame.Deadline = "2022-06-01 10:05:00 +0000 UTC"
+	storedGame.Board = "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*R|r*r*r*r*"
+	now, err := time.Parse(types.DeadlineLayout, "2022-06-01 10:00:30 +0000 UTC")
+	require.Nil(t, err)
+	rendered, err := storedGame.Render(now)
+	require.Nil(t, err)
+	require.Equal(t, ""+
+		"Game 1, american rules, 2 moves played\n"+
+		"    a b c d e f g h\n"+
+		" 8    b   b   b   b  8    1  2  3  4\n"+
+		" 7  b   b   b   b    7    5  6  7  8\n"+
+		" 6    .   b   b   b  6    9 10 11 12\n"+
+		" 5  .   b   .   .    5   13 14 15 16\n"+
+		" 4    r   .   .   .  4   17 18 19 20\n"+
+		" 3  .   r   r   r    3   21 22 23 24\n"+
+		" 2    r   r   r   R  2   25 26 27 28\n"+
+		" 1  r   r   r   r    1   29 30 31 32\n"+
+		"    a b c d e f g h\n"+
+		"    b r: men, B R: kings\n"+
+		"Black:    "+alice+"\n"+
+		"Red:      "+bob+"\n"+
+		"Wager:    45stake\n"+
+		"To move:  black\n"+
+		"Deadline: 2022-06-01 10:05:00 +0000 UTC (4m30s left)\n",
+		rendered)
+}
+
+func TestRenderExpiredAndFinishedGames(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "*"
+	storedGame.Deadline = "2022-06-01 10:05:00 +0000 UTC"
+	now, err := time.Parse(types.DeadlineLayout, "2022-06-01 11:05:00 +0000 UTC")
+	require.Nil(t, err)
+	rendered, err := storedGame.Render(now)
+	require.Nil(t, err)
+	require.True(t, strings.HasSuffix(rendered, "(expired 1h0m0s ago)\n"))
+
+	storedGame.Winner = "r"
+	storedGame.Board = ""
+	rendered, err = storedGame.Render(now)
+	require.Nil(t, err)
+	require.Equal(t, ""+
+		"Game 1, american rules, 0 moves played\n"+
+		"Black:    "+alice+"\n"+
+		"Red:      "+bob+"\n"+
+		"Wager:    0stake\n"+
+		"Winner:   red\n",
+		rendered)
+}
+
+func TestRenderPendingGame(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "*"
+	storedGame.AwaitingRed = true
+	storedGame.Deadline = "2022-06-01 11:00:00 +0000 UTC"
+	now, err := time.Parse(types.DeadlineLayout, "2022-06-01 10:00:00 +0000 UTC")
+	require.Nil(t, err)
+	rendered, err := storedGame.Render(now)
+	require.Nil(t, err)
+	require.True(t, strings.HasSuffix(rendered, ""+
+		"Invited:  red, yet to accept\n"+
+		"Deadline: 2022-06-01 11:00:00 +0000 UTC (1h0m0s left)\n"))
+
+	storedGame.AwaitingBlack = true
+	rendered, err = storedGame.Render(now)
+	require.Nil(t, err)
+	require.Contains(t, rendered, "Invited:  black and red, yet to accept\n")
+}
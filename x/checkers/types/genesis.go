@@ -2,6 +2,11 @@ package types
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // DefaultIndex is the default capability global index
@@ -11,9 +16,11 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		SystemInfo: &SystemInfo{
-			NextId:        uint64(DefaultIndex),
-			FifoHeadIndex: NoFifoIndex,
-			FifoTailIndex: NoFifoIndex,
+			NextId:          uint64(DefaultIndex),
+			FifoHeadIndex:   NoFifoIndex,
+			FifoTailIndex:   NoFifoIndex,
+			InviteHeadIndex: NoFifoIndex,
+			InviteTailIndex: NoFifoIndex,
 		},
 		StoredGameList: []StoredGame{},
 		// this line is used by starport scaffolding # genesis/types/default
@@ -24,8 +31,15 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if gs.SystemInfo == nil {
+		return fmt.Errorf("systemInfo is missing")
+	}
+	if _, err := gs.GetDeadlineShift(time.Time{}); err != nil {
+		return err
+	}
 	// Check for duplicated index in storedGame
 	storedGameIndexMap := make(map[string]struct{})
+	games := make(map[string]StoredGame, len(gs.StoredGameList))
 
 	for _, elem := range gs.StoredGameList {
 		index := string(StoredGameKey(elem.Index))
@@ -33,8 +47,37 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for storedGame")
 		}
 		storedGameIndexMap[index] = struct{}{}
+		games[elem.Index] = elem
+
+		id, err := strconv.ParseUint(elem.Index, 10, 64)
+		if err != nil {
+			return fmt.Errorf("storedGame index is not a number: %s", elem.Index)
+		}
+		if gs.SystemInfo.NextId <= id {
+			return fmt.Errorf("systemInfo nextId %d is not above storedGame index %s", gs.SystemInfo.NextId, elem.Index)
+		}
+		if err := elem.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "storedGame %s", elem.Index)
+		}
+	}
+	// The games are in place, so the lists can be walked
+	if problems := FifoProblems(*gs.SystemInfo, games); len(problems) != 0 {
+		return fmt.Errorf("game queues are inconsistent: %s", strings.Join(problems, "; "))
 	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// GetDeadlineShift returns by how much the deadlines of unfinished games have
+// to move so that they count from the genesis time instead of the deadline base.
+func (gs GenesisState) GetDeadlineShift(genesisTime time.Time) (time.Duration, error) {
+	if gs.DeadlineBase == "" {
+		return 0, nil
+	}
+	base, err := time.Parse(DeadlineLayout, gs.DeadlineBase)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(err, "deadlineBase can not be parsed: %s", gs.DeadlineBase)
+	}
+	return genesisTime.Sub(base), nil
+}
@@ -28,6 +28,9 @@ type GenesisState struct {
 	Params         Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	SystemInfo     *SystemInfo  `protobuf:"bytes,2,opt,name=systemInfo,proto3" json:"systemInfo,omitempty"`
 	StoredGameList []StoredGame `protobuf:"bytes,3,rep,name=storedGameList,proto3" json:"storedGameList"`
+	// Block time the deadlines of unfinished games were exported at. When set,
+	// the deadlines are shifted so that they count from the genesis time instead.
+	DeadlineBase string `protobuf:"bytes,4,opt,name=deadlineBase,proto3" json:"deadlineBase,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +87,13 @@ func (m *GenesisState) GetStoredGameList() []StoredGame {
 	return nil
 }
 
+func (m *GenesisState) GetDeadlineBase() string {
+	if m != nil {
+		return m.DeadlineBase
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "letrongdat.checkers.checkers.GenesisState")
 }
@@ -91,25 +101,26 @@ func init() {
 func init() { proto.RegisterFile("checkers/genesis.proto", fileDescriptor_6e928243c164a8dc) }
 
 var fileDescriptor_6e928243c164a8dc = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
+	// 300 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xce, 0x48, 0x4d,
 	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
 	0x2f, 0xc9, 0x17, 0x92, 0xc9, 0x49, 0x2d, 0x29, 0xca, 0xcf, 0x4b, 0x4f, 0x49, 0x2c, 0xd1, 0x83,
 	0x29, 0x81, 0x33, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x0a, 0xf5, 0x41, 0x2c, 0x88, 0x1e,
 	0x29, 0x51, 0xb8, 0x59, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0xa3, 0xa4, 0xa4, 0xe0, 0xc2, 0xc5,
 	0x95, 0xc5, 0x25, 0xa9, 0xb9, 0xf1, 0x99, 0x79, 0x69, 0xf9, 0x98, 0x72, 0x25, 0xf9, 0x45, 0xa9,
-	0x29, 0xf1, 0xe9, 0x89, 0xb9, 0xa9, 0x10, 0x39, 0xa5, 0x2f, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0x47,
+	0x29, 0xf1, 0xe9, 0x89, 0xb9, 0xa9, 0x10, 0x39, 0xa5, 0x19, 0x4c, 0x5c, 0x3c, 0xee, 0x10, 0x47,
 	0x05, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x39, 0x71, 0xb1, 0x41, 0x0c, 0x96, 0x60, 0x54, 0x60, 0xd4,
 	0xe0, 0x36, 0x52, 0xd1, 0xc3, 0xe7, 0x48, 0xbd, 0x00, 0xb0, 0x5a, 0x27, 0x96, 0x13, 0xf7, 0xe4,
 	0x19, 0x82, 0xa0, 0x3a, 0x85, 0x3c, 0xb8, 0xb8, 0x20, 0xae, 0xf0, 0xcc, 0x4b, 0xcb, 0x97, 0x60,
 	0x02, 0x9b, 0xa3, 0x81, 0xdf, 0x9c, 0x60, 0xb8, 0xfa, 0x20, 0x24, 0xbd, 0x42, 0x61, 0x5c, 0x7c,
 	0x10, 0x37, 0xbb, 0x27, 0xe6, 0xa6, 0xfa, 0x64, 0x16, 0x97, 0x48, 0x30, 0x2b, 0x30, 0x13, 0x61,
-	0x1a, 0x5c, 0x0f, 0xd4, 0x65, 0x68, 0xa6, 0x38, 0x79, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x7e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe,
-	0x4f, 0x6a, 0x08, 0xc8, 0x0e, 0x97, 0xc4, 0x12, 0x7d, 0x78, 0x10, 0x56, 0x20, 0x98, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x80, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x53, 0x93,
-	0x49, 0xd3, 0xe5, 0x01, 0x00, 0x00,
+	0x1a, 0x5c, 0x0f, 0xd4, 0x65, 0x68, 0xa6, 0x08, 0x29, 0x71, 0xf1, 0xa4, 0xa4, 0x26, 0xa6, 0xe4,
+	0x64, 0xe6, 0xa5, 0x3a, 0x25, 0x16, 0xa7, 0x4a, 0xb0, 0x28, 0x30, 0x6a, 0x70, 0x06, 0xa1, 0x88,
+	0x39, 0x79, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
+	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x7e, 0x7a, 0x66,
+	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x4f, 0x6a, 0x08, 0xc8, 0x1d, 0x2e, 0x89,
+	0x25, 0xfa, 0xf0, 0x60, 0xae, 0x40, 0x30, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81,
+	0x6d, 0x0c, 0x18, 0x00, 0x99, 0x2c, 0xfd, 0x70, 0x09, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +143,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeadlineBase) > 0 {
+		i -= len(m.DeadlineBase)
+		copy(dAtA[i:], m.DeadlineBase)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeadlineBase)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.StoredGameList) > 0 {
 		for iNdEx := len(m.StoredGameList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -200,6 +218,10 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.DeadlineBase)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -341,6 +363,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadlineBase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/LeTrongDat/checkers/x/checkers/types"
 	"github.com/stretchr/testify/require"
@@ -23,15 +24,15 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 
 				SystemInfo: &types.SystemInfo{
-					NextId: 51,
+					NextId:          51,
+					FifoHeadIndex:   "1",
+					FifoTailIndex:   "1",
+					InviteHeadIndex: "-1",
+					InviteTailIndex: "-1",
 				},
 				StoredGameList: []types.StoredGame{
-					{
-						Index: "0",
-					},
-					{
-						Index: "1",
-					},
+					getFinishedGame("0"),
+					getOngoingGame("1"),
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -40,13 +41,16 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "duplicated storedGame",
 			genState: &types.GenesisState{
+				SystemInfo: &types.SystemInfo{
+					NextId:          51,
+					FifoHeadIndex:   "-1",
+					FifoTailIndex:   "-1",
+					InviteHeadIndex: "-1",
+					InviteTailIndex: "-1",
+				},
 				StoredGameList: []types.StoredGame{
-					{
-						Index: "0",
-					},
-					{
-						Index: "0",
-					},
+					getFinishedGame("0"),
+					getFinishedGame("0"),
 				},
 			},
 			valid: false,
@@ -69,8 +73,110 @@ func TestDefaultGenesisState_ExpectedInitialNextId(t *testing.T) {
 		&types.GenesisState{
 			StoredGameList: []types.StoredGame{},
 			SystemInfo: &types.SystemInfo{
-				NextId: uint64(1),
+				NextId:          uint64(1),
+				FifoHeadIndex:   "-1",
+				FifoTailIndex:   "-1",
+				InviteHeadIndex: "-1",
+				InviteTailIndex: "-1",
 			},
+			Params: types.DefaultParams(),
 		},
 		types.DefaultGenesis())
 }
+
+func getOngoingGame(index string) types.StoredGame {
+	storedGame := *GetStoredGame1()
+	storedGame.Index = index
+	storedGame.BeforeIndex = types.NoFifoIndex
+	storedGame.AfterIndex = types.NoFifoIndex
+	return storedGame
+}
+
+func getFinishedGame(index string) types.StoredGame {
+	storedGame := getOngoingGame(index)
+	storedGame.Winner = "r"
+	storedGame.Board = ""
+	return storedGame
+}
+
+func getGenesisWithOneGame(storedGame types.StoredGame) types.GenesisState {
+	return types.GenesisState{
+		SystemInfo: &types.SystemInfo{
+			NextId:          2,
+			FifoHeadIndex:   "1",
+			FifoTailIndex:   "1",
+			InviteHeadIndex: "-1",
+			InviteTailIndex: "-1",
+		},
+		StoredGameList: []types.StoredGame{storedGame},
+	}
+}
+
+func TestGenesisStateValidateOneGameOk(t *testing.T) {
+	genState := getGenesisWithOneGame(getOngoingGame("1"))
+	require.NoError(t, genState.Validate())
+}
+
+func TestGenesisStateValidateMissingSystemInfo(t *testing.T) {
+	genState := getGenesisWithOneGame(getOngoingGame("1"))
+	genState.SystemInfo = nil
+	require.EqualError(t, genState.Validate(), "systemInfo is missing")
+}
+
+func TestGenesisStateValidateNextIdTooLow(t *testing.T) {
+	genState := getGenesisWithOneGame(getOngoingGame("1"))
+	genState.SystemInfo.NextId = 1
+	require.EqualError(t, genState.Validate(), "systemInfo nextId 1 is not above storedGame index 1")
+}
+
+func TestGenesisStateValidateIndexNotNumber(t *testing.T) {
+	genState := getGenesisWithOneGame(getOngoingGame("one"))
+	require.EqualError(t, genState.Validate(), "storedGame index is not a number: one")
+}
+
+func TestGenesisStateValidateInvalidGame(t *testing.T) {
+	storedGame := getOngoingGame("1")
+	storedGame.Red = "cosmos1"
+	genState := getGenesisWithOneGame(storedGame)
+	require.ErrorIs(t, genState.Validate(), types.ErrInvalidRed)
+	require.ErrorContains(t, genState.Validate(), "storedGame 1: cosmos1: ")
+}
+
+func TestGenesisStateValidateWinnerOnBoard(t *testing.T) {
+	storedGame := getOngoingGame("1")
+	storedGame.Winner = "b"
+	genState := getGenesisWithOneGame(storedGame)
+	require.EqualError(t, genState.Validate(), "storedGame 1: board not cleared after b won: winner does not match the board")
+}
+
+func TestGenesisStateValidateFinishedGameInFifo(t *testing.T) {
+	genState := getGenesisWithOneGame(getFinishedGame("1"))
+	require.EqualError(t, genState.Validate(), "game queues are inconsistent: fifo: game 1 does not belong in it")
+}
+
+func TestGenesisStateValidateBrokenFifo(t *testing.T) {
+	genState := getGenesisWithOneGame(getOngoingGame("1"))
+	genState.SystemInfo.FifoTailIndex = "-1"
+	require.EqualError(t, genState.Validate(), "game queues are inconsistent: "+
+		"fifo: head 1 and tail -1 are not both -1; fifo: tail is -1 instead of 1")
+}
+
+func TestGenesisStateValidateWrongDeadlineBase(t *testing.T) {
+	genState := getGenesisWithOneGame(getOngoingGame("1"))
+	genState.DeadlineBase = "yesterday"
+	require.ErrorContains(t, genState.Validate(), "deadlineBase can not be parsed: yesterday: ")
+}
+
+func TestGenesisStateGetDeadlineShift(t *testing.T) {
+	genState := getGenesisWithOneGame(getOngoingGame("1"))
+	genesisTime, err := time.Parse(types.DeadlineLayout, "2022-06-01 12:00:00 +0000 UTC")
+	require.Nil(t, err)
+	shift, err := genState.GetDeadlineShift(genesisTime)
+	require.Nil(t, err)
+	require.Equal(t, time.Duration(0), shift)
+
+	genState.DeadlineBase = "2022-06-01 10:00:00 +0000 UTC"
+	shift, err = genState.GetDeadlineShift(genesisTime)
+	require.Nil(t, err)
+	require.Equal(t, 2*time.Hour, shift)
+}
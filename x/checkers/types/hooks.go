@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple checkers hooks, all hook functions are run in array sequence
+type MultiCheckersHooks []CheckersHooks
+
+func NewMultiCheckersHooks(hooks ...CheckersHooks) MultiCheckersHooks {
+	return hooks
+}
+
+func (h MultiCheckersHooks) AfterGameCreated(ctx sdk.Context, storedGame StoredGame) {
+	for i := range h {
+		h[i].AfterGameCreated(ctx, storedGame)
+	}
+}
+
+func (h MultiCheckersHooks) AfterMovePlayed(ctx sdk.Context, storedGame StoredGame, player sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterMovePlayed(ctx, storedGame, player)
+	}
+}
+
+func (h MultiCheckersHooks) AfterGameEnded(ctx sdk.Context, storedGame StoredGame, winner sdk.AccAddress, loser sdk.AccAddress, reason string) {
+	for i := range h {
+		h[i].AfterGameEnded(ctx, storedGame, winner, loser, reason)
+	}
+}
@@ -34,6 +34,7 @@ const (
 	GameCreatedEventBlack     = "black"
 	GameCreatedEventRed       = "red"
 	GameCreatedEventWager     = "wager"
+	GameCreatedEventVariant   = "variant"
 )
 
 const (
@@ -44,6 +45,41 @@ const (
 	MovePlayedEventCapturedY = "capture-y"
 	MovePlayedEventWinner    = "winner"
 	MovePlayedEventBoard     = "board"
+	MovePlayedEventMove      = "move"
+	MovePlayedEventFromX     = "from-x"
+	MovePlayedEventFromY     = "from-y"
+	MovePlayedEventToX       = "to-x"
+	MovePlayedEventToY       = "to-y"
+	MovePlayedEventCrowned   = "crowned"
+	MovePlayedEventNextTurn  = "next-turn"
+	MovePlayedEventMoveCount = "move-count"
+	MovePlayedEventDeadline  = "deadline"
+)
+
+const (
+	GameEndedEventType        = "game-ended"
+	GameEndedEventGameIndex   = "game-index"
+	GameEndedEventWinner      = "winner"
+	GameEndedEventReason      = "reason"
+	GameEndedEventBlackPayout = "black-payout"
+	GameEndedEventRedPayout   = "red-payout"
+)
+
+const (
+	// The last piece of the loser was captured
+	GameEndedReasonCaptureOut = "capture-out"
+	// The loser cannot move any of its pieces
+	GameEndedReasonBlocked = "blocked"
+	// The player to move let the deadline pass
+	GameEndedReasonForfeit = "forfeit"
+	// Reserved for when players can resign or agree to a draw
+	GameEndedReasonResign = "resign"
+	GameEndedReasonDraw   = "draw"
+	// A player rejected the game before playing, which cancels it
+	GameEndedReasonRejected = "rejected"
+	// The invitation was not accepted in time, or the deadline passed before
+	// both players played, which cancels the game
+	GameEndedReasonExpired = "expired"
 )
 
 const (
@@ -52,6 +88,18 @@ const (
 	GameRejectedEventGameIndex = "game-index"
 )
 
+const (
+	InviteAcceptedEventType      = "invite-accepted"
+	InviteAcceptedEventCreator   = "creator"
+	InviteAcceptedEventGameIndex = "game-index"
+	InviteAcceptedEventStarted   = "started"
+)
+
+const (
+	InviteExpiredEventType      = "invite-expired"
+	InviteExpiredEventGameIndex = "game-index"
+)
+
 const (
 	NoFifoIndex = "-1"
 )
@@ -59,7 +107,9 @@ const (
 const (
 	// MaxTurnDuration = time.Duration(24 * 3_600 * 1000_000_000) // 1 day
 	MaxTurnDuration = time.Duration(5 * 60 * 1000_000_000) // 5 minutes
-	DeadlineLayout  = "2006-01-02 15:04:05.999999999 +0000 UTC"
+	// MaxInviteDuration is how long invited players have to accept a game.
+	MaxInviteDuration = time.Duration(60 * 60 * 1000_000_000) // 1 hour
+	DeadlineLayout    = "2006-01-02 15:04:05.999999999 +0000 UTC"
 )
 
 const (
@@ -2,19 +2,19 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const TypeMsgCreateGame = "create_game"
 
 var _ sdk.Msg = &MsgCreateGame{}
 
-func NewMsgCreateGame(creator string, black string, red string, wager uint64) *MsgCreateGame {
+func NewMsgCreateGame(creator string, black string, red string, wager uint64, variant string) *MsgCreateGame {
 	return &MsgCreateGame{
 		Creator: creator,
 		Black:   black,
 		Red:     red,
 		Wager:   wager,
+		Variant: variant,
 	}
 }
 
@@ -40,9 +40,15 @@ func (msg *MsgCreateGame) GetSignBytes() []byte {
 }
 
 func (msg *MsgCreateGame) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Creator)
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	return msg.GetCreateGameParams().Validate()
+}
+
+func (msg *MsgCreateGame) GetCreateGameParams() CreateGameParams {
+	return CreateGameParams{
+		Creator: msg.Creator,
+		Black:   msg.Black,
+		Red:     msg.Red,
+		Wager:   msg.Wager,
+		Variant: msg.Variant,
 	}
-	return nil
 }
@@ -24,6 +24,8 @@ func TestMsgCreateGame_ValidateBasic(t *testing.T) {
 			name: "valid address",
 			msg: MsgCreateGame{
 				Creator: sample.AccAddress(),
+				Black:   sample.AccAddress(),
+				Red:     sample.AccAddress(),
 			},
 		},
 	}
@@ -38,3 +40,32 @@ func TestMsgCreateGame_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgCreateGame_ValidateBasicVariant(t *testing.T) {
+	msg := MsgCreateGame{
+		Creator: sample.AccAddress(),
+		Black:   sample.AccAddress(),
+		Red:     sample.AccAddress(),
+		Variant: "chinese",
+	}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidVariant)
+	require.EqualError(t, msg.ValidateBasic(), "chinese: unknown variant: chinese: variant is invalid")
+	msg.Variant = "american"
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestMsgCreateGame_ValidateBasicPlayers(t *testing.T) {
+	player := sample.AccAddress()
+	msg := MsgCreateGame{
+		Creator: sample.AccAddress(),
+		Black:   "invalid_address",
+		Red:     player,
+	}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidBlack)
+	require.EqualError(t, msg.ValidateBasic(), "invalid_address: decoding bech32 failed: invalid separator index -1: black address is invalid")
+	msg.Black = player
+	require.NoError(t, msg.ValidateBasic())
+	msg.Red = ""
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidRed)
+	require.EqualError(t, msg.ValidateBasic(), ": empty address string is not allowed: red address is invalid")
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/LeTrongDat/checkers/x/checkers/rules"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -20,6 +21,14 @@ func NewMsgPlayMove(creator string, gameIndex string, fromX uint64, fromY uint64
 	}
 }
 
+func NewMsgPlayMoveNotation(creator string, gameIndex string, move string) *MsgPlayMove {
+	return &MsgPlayMove{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Move:      move,
+	}
+}
+
 func (msg *MsgPlayMove) Route() string {
 	return RouterKey
 }
@@ -46,5 +55,55 @@ func (msg *MsgPlayMove) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if msg.Move == "" {
+		return msg.validateCoordinates()
+	}
+	if msg.FromX != 0 || msg.FromY != 0 || msg.ToX != 0 || msg.ToY != 0 {
+		return sdkerrors.Wrapf(ErrInvalidMoveNotation, "%s: coordinates given too", msg.Move)
+	}
+	// The board size is only known with the game, so it is checked against the largest one.
+	if _, err := rules.LARGEST_BOARD_VARIANT.ParseMove(msg.Move); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMoveNotation, "%s: %s", msg.Move, err)
+	}
 	return nil
 }
+
+// validateCoordinates checks what can be checked without the game: the
+// positions are dark squares of the largest board, on the same diagonal.
+func (msg *MsgPlayMove) validateCoordinates() error {
+	if rules.MAX_BOARD_DIM <= msg.FromX || rules.MAX_BOARD_DIM <= msg.FromY || rules.MAX_BOARD_DIM <= msg.ToX || rules.MAX_BOARD_DIM <= msg.ToY {
+		return sdkerrors.Wrapf(ErrWrongMove, "position out of the %dx%d board", rules.MAX_BOARD_DIM, rules.MAX_BOARD_DIM)
+	}
+	src := rules.Pos{X: int(msg.FromX), Y: int(msg.FromY)}
+	dst := rules.Pos{X: int(msg.ToX), Y: int(msg.ToY)}
+	for _, pos := range []rules.Pos{src, dst} {
+		if rules.LARGEST_BOARD_VARIANT.SquareNumber(pos) == 0 {
+			return sdkerrors.Wrapf(ErrWrongMove, "not a dark square: %s", rules.FormatPos(pos))
+		}
+	}
+	dx, dy := dst.X-src.X, dst.Y-src.Y
+	if dx == 0 || (dx != dy && dx != -dy) {
+		return sdkerrors.Wrapf(ErrWrongMove, "not a diagonal move: %s to %s", rules.FormatPos(src), rules.FormatPos(dst))
+	}
+	return nil
+}
+
+// GetSteps returns the steps of the move in the game's variant, from its
+// notation if it has one, or else from its coordinates.
+func (msg *MsgPlayMove) GetSteps(variant *rules.Variant) (steps []rules.Step, err error) {
+	if msg.Move != "" {
+		steps, err = variant.ParseMove(msg.Move)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidMoveNotation, "%s: %s", msg.Move, err)
+		}
+		return steps, nil
+	}
+	dim := uint64(variant.BoardDim)
+	if dim <= msg.FromX || dim <= msg.FromY || dim <= msg.ToX || dim <= msg.ToY {
+		return nil, sdkerrors.Wrapf(ErrWrongMove, "position out of the %dx%d board", dim, dim)
+	}
+	return []rules.Step{{
+		Src: rules.Pos{X: int(msg.FromX), Y: int(msg.FromY)},
+		Dst: rules.Pos{X: int(msg.ToX), Y: int(msg.ToY)},
+	}}, nil
+}
@@ -24,7 +24,80 @@ func TestMsgPlayMove_ValidateBasic(t *testing.T) {
 			name: "valid address",
 			msg: MsgPlayMove{
 				Creator: sample.AccAddress(),
+				FromX:   1,
+				FromY:   2,
+				ToX:     0,
+				ToY:     3,
 			},
+		}, {
+			name: "valid capture on a large board",
+			msg: MsgPlayMove{
+				Creator: sample.AccAddress(),
+				FromX:   9,
+				FromY:   6,
+				ToX:     7,
+				ToY:     8,
+			},
+		}, {
+			name: "out of the board",
+			msg: MsgPlayMove{
+				Creator: sample.AccAddress(),
+				FromX:   1000,
+				FromY:   2,
+				ToX:     0,
+				ToY:     3,
+			},
+			err: ErrWrongMove,
+		}, {
+			name: "light square",
+			msg: MsgPlayMove{
+				Creator: sample.AccAddress(),
+				FromX:   0,
+				FromY:   2,
+				ToX:     1,
+				ToY:     3,
+			},
+			err: ErrWrongMove,
+		}, {
+			name: "not diagonal",
+			msg: MsgPlayMove{
+				Creator: sample.AccAddress(),
+				FromX:   1,
+				FromY:   2,
+				ToX:     1,
+				ToY:     4,
+			},
+			err: ErrWrongMove,
+		}, {
+			name: "not moving",
+			msg: MsgPlayMove{
+				Creator: sample.AccAddress(),
+				FromX:   1,
+				FromY:   2,
+				ToX:     1,
+				ToY:     2,
+			},
+			err: ErrWrongMove,
+		}, {
+			name: "valid notation",
+			msg: MsgPlayMove{
+				Creator: sample.AccAddress(),
+				Move:    "11x18x25",
+			},
+		}, {
+			name: "valid algebraic notation on a large board",
+			msg: MsgPlayMove{
+				Creator: sample.AccAddress(),
+				Move:    "j10-i9",
+			},
+		}, {
+			name: "notation and coordinates",
+			msg: MsgPlayMove{
+				Creator: sample.AccAddress(),
+				FromX:   1,
+				Move:    "11-15",
+			},
+			err: ErrInvalidMoveNotation,
 		},
 	}
 	for _, tt := range tests {
@@ -38,3 +111,30 @@ func TestMsgPlayMove_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPlayMove_ValidateBasicNotation(t *testing.T) {
+	msg := MsgPlayMove{
+		Creator: sample.AccAddress(),
+		Move:    "11-51",
+	}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidMoveNotation)
+	require.EqualError(t, msg.ValidateBasic(), "11-51: square out of the board: 51: move notation is invalid")
+	msg.Move = "a2-b3"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidMoveNotation)
+	require.EqualError(t, msg.ValidateBasic(), "a2-b3: not a dark square of the board: a2: move notation is invalid")
+}
+
+func TestMsgPlayMove_ValidateBasicCoordinates(t *testing.T) {
+	msg := MsgPlayMove{
+		Creator: sample.AccAddress(),
+		FromX:   1,
+		FromY:   2,
+		ToX:     10,
+		ToY:     3,
+	}
+	require.EqualError(t, msg.ValidateBasic(), "position out of the 10x10 board: wrong move")
+	msg.ToX = 1
+	require.EqualError(t, msg.ValidateBasic(), "not a dark square: 1,3: wrong move")
+	msg.ToX, msg.ToY = 4, 3
+	require.EqualError(t, msg.ValidateBasic(), "not a diagonal move: 1,2 to 4,3: wrong move")
+}
@@ -1,35 +1,60 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyAllowSelfPlay         = []byte("AllowSelfPlay")
+	DefaultAllowSelfPlay     = false
+	KeyCreatorMustPlay       = []byte("CreatorMustPlay")
+	DefaultCreatorMustPlay   = false
+	KeyRequireAcceptance     = []byte("RequireAcceptance")
+	DefaultRequireAcceptance = false
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(allowSelfPlay bool, creatorMustPlay bool, requireAcceptance bool) Params {
+	return Params{
+		AllowSelfPlay:     allowSelfPlay,
+		CreatorMustPlay:   creatorMustPlay,
+		RequireAcceptance: requireAcceptance,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultAllowSelfPlay, DefaultCreatorMustPlay, DefaultRequireAcceptance)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowSelfPlay, &p.AllowSelfPlay, validateBool),
+		paramtypes.NewParamSetPair(KeyCreatorMustPlay, &p.CreatorMustPlay, validateBool),
+		paramtypes.NewParamSetPair(KeyRequireAcceptance, &p.RequireAcceptance, validateBool),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateBool(p.AllowSelfPlay); err != nil {
+		return err
+	}
+	if err := validateBool(p.CreatorMustPlay); err != nil {
+		return err
+	}
+	return validateBool(p.RequireAcceptance)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +62,10 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateBool(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
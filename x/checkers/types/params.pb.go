@@ -25,6 +25,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// allow_self_play lets the same address play black and red, which is only
+	// meant for testnets.
+	AllowSelfPlay bool `protobuf:"varint,1,opt,name=allow_self_play,json=allowSelfPlay,proto3" json:"allow_self_play,omitempty" yaml:"allow_self_play"`
+	// creator_must_play rejects the games created by someone who plays neither
+	// black nor red.
+	CreatorMustPlay bool `protobuf:"varint,2,opt,name=creator_must_play,json=creatorMustPlay,proto3" json:"creator_must_play,omitempty" yaml:"creator_must_play"`
+	// require_acceptance keeps new games pending until the invited players have
+	// accepted them with MsgAcceptInvite.
+	RequireAcceptance bool `protobuf:"varint,3,opt,name=require_acceptance,json=requireAcceptance,proto3" json:"require_acceptance,omitempty" yaml:"require_acceptance"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +68,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAllowSelfPlay() bool {
+	if m != nil {
+		return m.AllowSelfPlay
+	}
+	return false
+}
+
+func (m *Params) GetCreatorMustPlay() bool {
+	if m != nil {
+		return m.CreatorMustPlay
+	}
+	return false
+}
+
+func (m *Params) GetRequireAcceptance() bool {
+	if m != nil {
+		return m.RequireAcceptance
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "letrongdat.checkers.checkers.Params")
 }
@@ -66,17 +96,26 @@ func init() {
 func init() { proto.RegisterFile("checkers/params.proto", fileDescriptor_ec14988318ba9aaa) }
 
 var fileDescriptor_ec14988318ba9aaa = []byte{
-	// 155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0xc9, 0x49, 0x2d, 0x29, 0xca, 0xcf, 0x4b, 0x4f, 0x49, 0x2c, 0xd1, 0x83, 0xa9,
-	0x80, 0x33, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x0a, 0xf5, 0x41, 0x2c, 0x88, 0x1e, 0x25,
-	0x3e, 0x2e, 0xb6, 0x00, 0xb0, 0x19, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0x79, 0x9e, 0x78,
-	0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c,
-	0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x7e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92,
-	0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x4f, 0x6a, 0x08, 0xc8, 0x22, 0x97, 0xc4, 0x12, 0x7d, 0xb8, 0x53,
-	0x2a, 0x10, 0xcc, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x0d, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x20, 0x6c, 0x5a, 0x18, 0xae, 0x00, 0x00, 0x00,
+	// 289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x31, 0x4b, 0xc3, 0x40,
+	0x18, 0x86, 0x73, 0x2a, 0x45, 0x02, 0x52, 0x1a, 0x54, 0x62, 0xa9, 0x17, 0xc9, 0xe4, 0x94, 0x0c,
+	0x6e, 0xdd, 0x0c, 0x0e, 0x0a, 0x15, 0x4a, 0x74, 0x72, 0x09, 0xd7, 0xf3, 0x6b, 0x5a, 0xbc, 0xf4,
+	0xe2, 0xdd, 0x05, 0xcd, 0xbf, 0x70, 0x74, 0xf4, 0xe7, 0x38, 0x76, 0x74, 0x0a, 0x92, 0x6c, 0x8e,
+	0xf9, 0x05, 0xd2, 0x4b, 0x8d, 0xd0, 0x6e, 0x1f, 0x0f, 0xcf, 0xf7, 0x0c, 0xaf, 0x79, 0x44, 0x67,
+	0x40, 0x9f, 0x40, 0x48, 0x3f, 0x25, 0x82, 0x24, 0xd2, 0x4b, 0x05, 0x57, 0xdc, 0x1a, 0x30, 0x50,
+	0x82, 0x2f, 0xe2, 0x47, 0xa2, 0xbc, 0x3f, 0xa3, 0x3d, 0xfa, 0x87, 0x31, 0x8f, 0xb9, 0x16, 0xfd,
+	0xd5, 0xd5, 0xfc, 0xb8, 0x3f, 0xc8, 0xec, 0x8c, 0x75, 0xc4, 0x0a, 0xcc, 0x2e, 0x61, 0x8c, 0xbf,
+	0x44, 0x12, 0xd8, 0x34, 0x4a, 0x19, 0xc9, 0x6d, 0x74, 0x86, 0xce, 0xf7, 0x83, 0x7e, 0x5d, 0x38,
+	0xc7, 0x39, 0x49, 0xd8, 0xd0, 0xdd, 0x10, 0xdc, 0xf0, 0x40, 0x93, 0x3b, 0x60, 0xd3, 0x31, 0x23,
+	0xb9, 0x75, 0x6d, 0xf6, 0xa8, 0x00, 0xa2, 0xb8, 0x88, 0x92, 0x4c, 0xaa, 0xa6, 0xb2, 0xa3, 0x2b,
+	0x83, 0xba, 0x70, 0xec, 0xa6, 0xb2, 0xa5, 0xb8, 0x61, 0x77, 0xcd, 0x6e, 0x33, 0xa9, 0x74, 0x69,
+	0x64, 0x5a, 0x02, 0x9e, 0xb3, 0xb9, 0x80, 0x88, 0x50, 0x0a, 0xa9, 0x22, 0x0b, 0x0a, 0xf6, 0xae,
+	0x4e, 0x9d, 0xd6, 0x85, 0x73, 0xd2, 0xa4, 0xb6, 0x1d, 0x37, 0xec, 0xad, 0xe1, 0x65, 0xcb, 0x86,
+	0x7b, 0xef, 0x1f, 0x8e, 0x11, 0xdc, 0x7c, 0x96, 0x18, 0x2d, 0x4b, 0x8c, 0xbe, 0x4b, 0x8c, 0xde,
+	0x2a, 0x6c, 0x2c, 0x2b, 0x6c, 0x7c, 0x55, 0xd8, 0x78, 0xf0, 0xe3, 0xb9, 0x9a, 0x65, 0x13, 0x8f,
+	0xf2, 0xc4, 0x1f, 0xc1, 0xfd, 0x6a, 0xc5, 0x2b, 0xa2, 0xfc, 0x76, 0xe7, 0xd7, 0xff, 0x53, 0xe5,
+	0x29, 0xc8, 0x49, 0x47, 0xcf, 0x77, 0xf1, 0x3b, 0x00, 0x6c, 0xa5, 0x5b, 0x0a, 0x8b, 0x01, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +138,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RequireAcceptance {
+		i--
+		if m.RequireAcceptance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreatorMustPlay {
+		i--
+		if m.CreatorMustPlay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AllowSelfPlay {
+		i--
+		if m.AllowSelfPlay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +188,15 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.AllowSelfPlay {
+		n += 2
+	}
+	if m.CreatorMustPlay {
+		n += 2
+	}
+	if m.RequireAcceptance {
+		n += 2
+	}
 	return n
 }
 
@@ -157,6 +235,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowSelfPlay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowSelfPlay = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorMustPlay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreatorMustPlay = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireAcceptance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireAcceptance = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
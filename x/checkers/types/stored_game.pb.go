@@ -34,6 +34,20 @@ type StoredGame struct {
 	Deadline    string `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	Winner      string `protobuf:"bytes,10,opt,name=winner,proto3" json:"winner,omitempty"`
 	Wager       uint64 `protobuf:"varint,11,opt,name=wager,proto3" json:"wager,omitempty"`
+	Variant     string `protobuf:"bytes,12,opt,name=variant,proto3" json:"variant,omitempty"`
+	Capturing   string `protobuf:"bytes,13,opt,name=capturing,proto3" json:"capturing,omitempty"`
+	Hash        uint64 `protobuf:"varint,14,opt,name=hash,proto3" json:"hash,omitempty"`
+	// awaitingBlack and awaitingRed are set while the invited player has not
+	// accepted the game yet. The deadline is then the expiry of the invitation.
+	AwaitingBlack bool `protobuf:"varint,15,opt,name=awaitingBlack,proto3" json:"awaitingBlack,omitempty"`
+	AwaitingRed   bool `protobuf:"varint,16,opt,name=awaitingRed,proto3" json:"awaitingRed,omitempty"`
+	// blackDeposit and redDeposit are what each player has escrowed so far.
+	// They are paid to the winner or refunded when the game ends.
+	BlackDeposit uint64 `protobuf:"varint,17,opt,name=blackDeposit,proto3" json:"blackDeposit,omitempty"`
+	RedDeposit   uint64 `protobuf:"varint,18,opt,name=redDeposit,proto3" json:"redDeposit,omitempty"`
+	// captured lists the pieces taken so far by the capturing piece. They stay
+	// on the board until the capture sequence ends.
+	Captured []string `protobuf:"bytes,19,rep,name=captured,proto3" json:"captured,omitempty"`
 }
 
 func (m *StoredGame) Reset()         { *m = StoredGame{} }
@@ -146,6 +160,62 @@ func (m *StoredGame) GetWager() uint64 {
 	return 0
 }
 
+func (m *StoredGame) GetVariant() string {
+	if m != nil {
+		return m.Variant
+	}
+	return ""
+}
+
+func (m *StoredGame) GetCapturing() string {
+	if m != nil {
+		return m.Capturing
+	}
+	return ""
+}
+
+func (m *StoredGame) GetHash() uint64 {
+	if m != nil {
+		return m.Hash
+	}
+	return 0
+}
+
+func (m *StoredGame) GetAwaitingBlack() bool {
+	if m != nil {
+		return m.AwaitingBlack
+	}
+	return false
+}
+
+func (m *StoredGame) GetAwaitingRed() bool {
+	if m != nil {
+		return m.AwaitingRed
+	}
+	return false
+}
+
+func (m *StoredGame) GetBlackDeposit() uint64 {
+	if m != nil {
+		return m.BlackDeposit
+	}
+	return 0
+}
+
+func (m *StoredGame) GetRedDeposit() uint64 {
+	if m != nil {
+		return m.RedDeposit
+	}
+	return 0
+}
+
+func (m *StoredGame) GetCaptured() []string {
+	if m != nil {
+		return m.Captured
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StoredGame)(nil), "letrongdat.checkers.checkers.StoredGame")
 }
@@ -153,26 +223,33 @@ func init() {
 func init() { proto.RegisterFile("checkers/stored_game.proto", fileDescriptor_8439c9c90688ff75) }
 
 var fileDescriptor_8439c9c90688ff75 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xcf, 0x4e, 0xc2, 0x40,
-	0x10, 0xc6, 0x59, 0xfe, 0x09, 0xc3, 0xc5, 0x6c, 0x8c, 0xd9, 0x10, 0xb2, 0x21, 0x9e, 0x38, 0xd1,
-	0x83, 0x6f, 0xa0, 0x26, 0x86, 0xc4, 0x13, 0x7a, 0xf2, 0x62, 0xb6, 0xdd, 0xa1, 0x34, 0xd0, 0x5d,
-	0xb2, 0x6c, 0x05, 0xdf, 0xc2, 0x27, 0xf0, 0x79, 0x3c, 0x72, 0xf4, 0x68, 0xda, 0x17, 0x31, 0x9d,
-	0x62, 0xe1, 0xf6, 0xfd, 0x7e, 0xfb, 0x4d, 0x32, 0xd9, 0x81, 0x61, 0xb4, 0xc4, 0x68, 0x85, 0x6e,
-	0x1b, 0x6c, 0xbd, 0x75, 0xa8, 0xdf, 0x62, 0x95, 0xe2, 0x74, 0xe3, 0xac, 0xb7, 0x7c, 0xb4, 0x46,
-	0xef, 0xac, 0x89, 0xb5, 0xf2, 0xd3, 0xff, 0x5a, 0x1d, 0x6e, 0xbe, 0x9a, 0x00, 0xcf, 0x34, 0xf3,
-	0xa8, 0x52, 0xe4, 0x57, 0xd0, 0x49, 0x8c, 0xc6, 0xbd, 0x60, 0x63, 0x36, 0xe9, 0xcf, 0x2b, 0x28,
-	0x6d, 0x68, 0x95, 0xd3, 0xa2, 0x59, 0x59, 0x02, 0x7e, 0x09, 0x2d, 0x87, 0x5a, 0xb4, 0xc8, 0x95,
-	0x91, 0x7a, 0x6b, 0x15, 0xad, 0x44, 0xfb, 0xd8, 0x2b, 0x81, 0x73, 0x68, 0xfb, 0xcc, 0x19, 0xd1,
-	0x21, 0x49, 0x99, 0x8f, 0xa0, 0x9f, 0xda, 0x77, 0xbc, 0xb7, 0x99, 0xf1, 0xa2, 0x3b, 0x66, 0x93,
-	0xf6, 0xfc, 0x24, 0xf8, 0x18, 0x06, 0x21, 0x2e, 0xac, 0xc3, 0x19, 0xed, 0x72, 0x41, 0x83, 0xe7,
-	0x8a, 0x4b, 0x00, 0xb5, 0xf0, 0xe8, 0xaa, 0x42, 0x8f, 0x0a, 0x67, 0x86, 0x0f, 0xa1, 0xa7, 0x51,
-	0xe9, 0x75, 0x62, 0x50, 0xf4, 0xe9, 0xb5, 0x66, 0x7e, 0x0d, 0xdd, 0x5d, 0x62, 0x0c, 0x3a, 0x01,
-	0xf4, 0x72, 0xa4, 0x72, 0xfb, 0x9d, 0x8a, 0xd1, 0x89, 0x01, 0xed, 0x53, 0xc1, 0xdd, 0xec, 0x3b,
-	0x97, 0xec, 0x90, 0x4b, 0xf6, 0x9b, 0x4b, 0xf6, 0x59, 0xc8, 0xc6, 0xa1, 0x90, 0x8d, 0x9f, 0x42,
-	0x36, 0x5e, 0x83, 0x38, 0xf1, 0xcb, 0x2c, 0x9c, 0x46, 0x36, 0x0d, 0x9e, 0xf0, 0xa5, 0xfc, 0xe3,
-	0x07, 0xe5, 0x83, 0xfa, 0x14, 0xfb, 0x53, 0xf4, 0x1f, 0x1b, 0xdc, 0x86, 0x5d, 0x3a, 0xc8, 0xed,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x15, 0x60, 0xcf, 0x70, 0xae, 0x01, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x92, 0xa6, 0xc9, 0xb4, 0x85, 0xb2, 0x20, 0x34, 0xaa, 0x2a, 0xcb, 0xaa, 0x38,
+	0xe4, 0x94, 0x1c, 0x78, 0x83, 0x52, 0x09, 0x55, 0xe2, 0x14, 0x38, 0x71, 0x41, 0x6b, 0xef, 0xd4,
+	0x5e, 0x35, 0xde, 0xb5, 0xd6, 0xeb, 0xa6, 0xbc, 0x05, 0xe2, 0xa9, 0x38, 0xf6, 0xc8, 0x11, 0x25,
+	0x2f, 0x52, 0xed, 0xb8, 0x4e, 0x9c, 0xdb, 0xfc, 0xdf, 0xfc, 0x6b, 0xcf, 0xfc, 0x1a, 0xb8, 0xc8,
+	0x0a, 0xca, 0xee, 0xc9, 0xd5, 0x8b, 0xda, 0x5b, 0x47, 0xea, 0x67, 0x2e, 0x4b, 0x9a, 0x57, 0xce,
+	0x7a, 0x2b, 0x2e, 0x57, 0xe4, 0x9d, 0x35, 0xb9, 0x92, 0x7e, 0xde, 0xd9, 0x76, 0xc5, 0xd5, 0x9f,
+	0x11, 0xc0, 0x37, 0x7e, 0xf3, 0x45, 0x96, 0x24, 0xde, 0xc3, 0x91, 0x36, 0x8a, 0x1e, 0x31, 0x4a,
+	0xa2, 0xd9, 0x74, 0xd9, 0x8a, 0x40, 0x53, 0x2b, 0x9d, 0xc2, 0x57, 0x2d, 0x65, 0x21, 0xce, 0x61,
+	0xe8, 0x48, 0xe1, 0x90, 0x59, 0x28, 0xd9, 0xb7, 0x92, 0xd9, 0x3d, 0x8e, 0x5e, 0x7c, 0x41, 0x08,
+	0x01, 0x23, 0xdf, 0x38, 0x83, 0x47, 0x0c, 0xb9, 0x16, 0x97, 0x30, 0x2d, 0xed, 0x03, 0x7d, 0xb6,
+	0x8d, 0xf1, 0x38, 0x4e, 0xa2, 0xd9, 0x68, 0xb9, 0x07, 0x22, 0x81, 0x93, 0x94, 0xee, 0xac, 0xa3,
+	0x5b, 0x9e, 0xe5, 0x98, 0x1f, 0xf6, 0x91, 0x88, 0x01, 0xe4, 0x9d, 0x27, 0xd7, 0x1a, 0x26, 0x6c,
+	0xe8, 0x11, 0x71, 0x01, 0x13, 0x45, 0x52, 0xad, 0xb4, 0x21, 0x9c, 0x72, 0x77, 0xa7, 0xc5, 0x07,
+	0x18, 0xaf, 0xb5, 0x31, 0xe4, 0x10, 0xb8, 0xf3, 0xa2, 0xc2, 0xf4, 0x6b, 0x99, 0x93, 0xc3, 0x13,
+	0x9e, 0xa7, 0x15, 0x02, 0xe1, 0xf8, 0x41, 0x3a, 0x2d, 0x8d, 0xc7, 0x53, 0xb6, 0x77, 0x32, 0xec,
+	0x90, 0xc9, 0xca, 0x37, 0x4e, 0x9b, 0x1c, 0xcf, 0xb8, 0xb7, 0x07, 0x61, 0xeb, 0x42, 0xd6, 0x05,
+	0xbe, 0xe6, 0x8f, 0x71, 0x2d, 0x3e, 0xc2, 0x99, 0x5c, 0x4b, 0xed, 0xb5, 0xc9, 0xaf, 0x39, 0xa7,
+	0x37, 0x49, 0x34, 0x9b, 0x2c, 0x0f, 0x61, 0xd8, 0xbe, 0x03, 0x4b, 0x52, 0x78, 0xce, 0x9e, 0x3e,
+	0x12, 0x57, 0x70, 0xca, 0xd1, 0xde, 0x50, 0x65, 0x6b, 0xed, 0xf1, 0x2d, 0xff, 0xe3, 0x80, 0x85,
+	0x84, 0x1c, 0xa9, 0xce, 0x21, 0xd8, 0xd1, 0x23, 0x21, 0xa1, 0x76, 0x58, 0x52, 0xf8, 0x2e, 0x19,
+	0x86, 0x84, 0x3a, 0x7d, 0x7d, 0xfb, 0x77, 0x13, 0x47, 0x4f, 0x9b, 0x38, 0xfa, 0xbf, 0x89, 0xa3,
+	0xdf, 0xdb, 0x78, 0xf0, 0xb4, 0x8d, 0x07, 0xff, 0xb6, 0xf1, 0xe0, 0xc7, 0x22, 0xd7, 0xbe, 0x68,
+	0xd2, 0x79, 0x66, 0xcb, 0xc5, 0x57, 0xfa, 0x1e, 0xee, 0xea, 0x46, 0xfa, 0xc5, 0xee, 0xfc, 0x1e,
+	0xf7, 0xa5, 0xff, 0x55, 0x51, 0x9d, 0x8e, 0xf9, 0x08, 0x3f, 0x3d, 0x0f, 0x00, 0x19, 0xa0, 0x92,
+	0x30, 0xa2, 0x02, 0x00, 0x00,
 }
 
 func (m *StoredGame) Marshal() (dAtA []byte, err error) {
@@ -195,6 +272,72 @@ func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Captured) > 0 {
+		for iNdEx := len(m.Captured) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Captured[iNdEx])
+			copy(dAtA[i:], m.Captured[iNdEx])
+			i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Captured[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.RedDeposit != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.RedDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.BlackDeposit != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.BlackDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.AwaitingRed {
+		i--
+		if m.AwaitingRed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.AwaitingBlack {
+		i--
+		if m.AwaitingBlack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Hash != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Capturing) > 0 {
+		i -= len(m.Capturing)
+		copy(dAtA[i:], m.Capturing)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Capturing)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.Wager != 0 {
 		i = encodeVarintStoredGame(dAtA, i, uint64(m.Wager))
 		i--
@@ -330,6 +473,35 @@ func (m *StoredGame) Size() (n int) {
 	if m.Wager != 0 {
 		n += 1 + sovStoredGame(uint64(m.Wager))
 	}
+	l = len(m.Variant)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.Capturing)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	if m.Hash != 0 {
+		n += 1 + sovStoredGame(uint64(m.Hash))
+	}
+	if m.AwaitingBlack {
+		n += 2
+	}
+	if m.AwaitingRed {
+		n += 3
+	}
+	if m.BlackDeposit != 0 {
+		n += 2 + sovStoredGame(uint64(m.BlackDeposit))
+	}
+	if m.RedDeposit != 0 {
+		n += 2 + sovStoredGame(uint64(m.RedDeposit))
+	}
+	if len(m.Captured) > 0 {
+		for _, s := range m.Captured {
+			l = len(s)
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -694,6 +866,199 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capturing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capturing = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			m.Hash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hash |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwaitingBlack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AwaitingBlack = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwaitingRed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AwaitingRed = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackDeposit", wireType)
+			}
+			m.BlackDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlackDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedDeposit", wireType)
+			}
+			m.RedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Captured", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Captured = append(m.Captured, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStoredGame(dAtA[iNdEx:])
@@ -26,6 +26,9 @@ type SystemInfo struct {
 	NextId        uint64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
 	FifoHeadIndex string `protobuf:"bytes,2,opt,name=fifoHeadIndex,proto3" json:"fifoHeadIndex,omitempty"`
 	FifoTailIndex string `protobuf:"bytes,3,opt,name=fifoTailIndex,proto3" json:"fifoTailIndex,omitempty"`
+	// The games waiting for their players to accept, by invitation expiry
+	InviteHeadIndex string `protobuf:"bytes,4,opt,name=inviteHeadIndex,proto3" json:"inviteHeadIndex,omitempty"`
+	InviteTailIndex string `protobuf:"bytes,5,opt,name=inviteTailIndex,proto3" json:"inviteTailIndex,omitempty"`
 }
 
 func (m *SystemInfo) Reset()         { *m = SystemInfo{} }
@@ -82,6 +85,20 @@ func (m *SystemInfo) GetFifoTailIndex() string {
 	return ""
 }
 
+func (m *SystemInfo) GetInviteHeadIndex() string {
+	if m != nil {
+		return m.InviteHeadIndex
+	}
+	return ""
+}
+
+func (m *SystemInfo) GetInviteTailIndex() string {
+	if m != nil {
+		return m.InviteTailIndex
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SystemInfo)(nil), "letrongdat.checkers.checkers.SystemInfo")
 }
@@ -89,20 +106,22 @@ func init() {
 func init() { proto.RegisterFile("checkers/system_info.proto", fileDescriptor_1580c0dd88c0be2b) }
 
 var fileDescriptor_1580c0dd88c0be2b = []byte{
-	// 202 bytes of a gzipped FileDescriptorProto
+	// 227 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x48, 0x4d,
 	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0xae, 0x2c, 0x2e, 0x49, 0xcd, 0x8d, 0xcf, 0xcc, 0x4b, 0xcb,
 	0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xc9, 0x49, 0x2d, 0x29, 0xca, 0xcf, 0x4b, 0x4f,
-	0x49, 0x2c, 0xd1, 0x83, 0x29, 0x83, 0x33, 0x94, 0x0a, 0xb8, 0xb8, 0x82, 0xc1, 0x5a, 0x3c, 0xf3,
-	0xd2, 0xf2, 0x85, 0xc4, 0xb8, 0xd8, 0xf2, 0x52, 0x2b, 0x4a, 0x3c, 0x53, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x58, 0x82, 0xa0, 0x3c, 0x21, 0x15, 0x2e, 0xde, 0xb4, 0xcc, 0xb4, 0x7c, 0x8f, 0xd4, 0xc4,
-	0x14, 0xcf, 0xbc, 0x94, 0xd4, 0x0a, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x54, 0x41, 0x98,
-	0xaa, 0x90, 0xc4, 0xcc, 0x1c, 0x88, 0x2a, 0x66, 0x84, 0x2a, 0xb8, 0xa0, 0x93, 0xe7, 0x89, 0x47,
-	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
-	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
-	0x25, 0xe7, 0xe7, 0xea, 0xfb, 0xa4, 0x86, 0x80, 0x1c, 0xed, 0x92, 0x58, 0xa2, 0x0f, 0xf7, 0x5b,
-	0x05, 0x82, 0x59, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xa1, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0x28, 0x38, 0x00, 0xe1, 0xff, 0x00, 0x00, 0x00,
+	0x49, 0x2c, 0xd1, 0x83, 0x29, 0x83, 0x33, 0x94, 0x8e, 0x30, 0x72, 0x71, 0x05, 0x83, 0xf5, 0x78,
+	0xe6, 0xa5, 0xe5, 0x0b, 0x89, 0x71, 0xb1, 0xe5, 0xa5, 0x56, 0x94, 0x78, 0xa6, 0x48, 0x30, 0x2a,
+	0x30, 0x6a, 0xb0, 0x04, 0x41, 0x79, 0x42, 0x2a, 0x5c, 0xbc, 0x69, 0x99, 0x69, 0xf9, 0x1e, 0xa9,
+	0x89, 0x29, 0x9e, 0x79, 0x29, 0xa9, 0x15, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0xa8, 0x82,
+	0x30, 0x55, 0x21, 0x89, 0x99, 0x39, 0x10, 0x55, 0xcc, 0x08, 0x55, 0x70, 0x41, 0x21, 0x0d, 0x2e,
+	0xfe, 0xcc, 0xbc, 0xb2, 0xcc, 0x92, 0x54, 0x84, 0x69, 0x2c, 0x60, 0x75, 0xe8, 0xc2, 0x08, 0x95,
+	0x08, 0x13, 0x59, 0x91, 0x55, 0xc2, 0x85, 0x9d, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
+	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
+	0x58, 0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf,
+	0x27, 0x35, 0x04, 0x14, 0x12, 0x2e, 0x89, 0x25, 0xfa, 0xf0, 0x00, 0xab, 0x40, 0x30, 0x4b, 0x2a,
+	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xc1, 0x66, 0x0c, 0x18, 0x00, 0x27, 0x87, 0xe3, 0xab, 0x54,
+	0x01, 0x00, 0x00,
 }
 
 func (m *SystemInfo) Marshal() (dAtA []byte, err error) {
@@ -125,6 +144,20 @@ func (m *SystemInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InviteTailIndex) > 0 {
+		i -= len(m.InviteTailIndex)
+		copy(dAtA[i:], m.InviteTailIndex)
+		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.InviteTailIndex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InviteHeadIndex) > 0 {
+		i -= len(m.InviteHeadIndex)
+		copy(dAtA[i:], m.InviteHeadIndex)
+		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.InviteHeadIndex)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.FifoTailIndex) > 0 {
 		i -= len(m.FifoTailIndex)
 		copy(dAtA[i:], m.FifoTailIndex)
@@ -175,6 +208,14 @@ func (m *SystemInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSystemInfo(uint64(l))
 	}
+	l = len(m.InviteHeadIndex)
+	if l > 0 {
+		n += 1 + l + sovSystemInfo(uint64(l))
+	}
+	l = len(m.InviteTailIndex)
+	if l > 0 {
+		n += 1 + l + sovSystemInfo(uint64(l))
+	}
 	return n
 }
 
@@ -296,6 +337,70 @@ func (m *SystemInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.FifoTailIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviteHeadIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSystemInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSystemInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSystemInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InviteHeadIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviteTailIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSystemInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSystemInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSystemInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InviteTailIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSystemInfo(dAtA[iNdEx:])
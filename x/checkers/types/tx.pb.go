@@ -32,6 +32,7 @@ type MsgCreateGame struct {
 	Black   string `protobuf:"bytes,2,opt,name=black,proto3" json:"black,omitempty"`
 	Red     string `protobuf:"bytes,3,opt,name=red,proto3" json:"red,omitempty"`
 	Wager   uint64 `protobuf:"varint,4,opt,name=wager,proto3" json:"wager,omitempty"`
+	Variant string `protobuf:"bytes,5,opt,name=variant,proto3" json:"variant,omitempty"`
 }
 
 func (m *MsgCreateGame) Reset()         { *m = MsgCreateGame{} }
@@ -95,6 +96,13 @@ func (m *MsgCreateGame) GetWager() uint64 {
 	return 0
 }
 
+func (m *MsgCreateGame) GetVariant() string {
+	if m != nil {
+		return m.Variant
+	}
+	return ""
+}
+
 type MsgCreateGameResponse struct {
 	GameIndex string `protobuf:"bytes,1,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
 }
@@ -146,6 +154,9 @@ type MsgPlayMove struct {
 	FromY     uint64 `protobuf:"varint,4,opt,name=fromY,proto3" json:"fromY,omitempty"`
 	ToX       uint64 `protobuf:"varint,5,opt,name=toX,proto3" json:"toX,omitempty"`
 	ToY       uint64 `protobuf:"varint,6,opt,name=toY,proto3" json:"toY,omitempty"`
+	// move is in numbered or algebraic notation, such as 11-15 or 11x18x25,
+	// and replaces the coordinates when set.
+	Move string `protobuf:"bytes,7,opt,name=move,proto3" json:"move,omitempty"`
 }
 
 func (m *MsgPlayMove) Reset()         { *m = MsgPlayMove{} }
@@ -223,6 +234,13 @@ func (m *MsgPlayMove) GetToY() uint64 {
 	return 0
 }
 
+func (m *MsgPlayMove) GetMove() string {
+	if m != nil {
+		return m.Move
+	}
+	return ""
+}
+
 type MsgPlayMoveResponse struct {
 	CapturedX int32  `protobuf:"varint,1,opt,name=capturedX,proto3" json:"capturedX,omitempty"`
 	CapturedY int32  `protobuf:"varint,2,opt,name=capturedY,proto3" json:"capturedY,omitempty"`
@@ -371,6 +389,103 @@ func (m *MsgRejectGameResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRejectGameResponse proto.InternalMessageInfo
 
+type MsgAcceptInvite struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	GameIndex string `protobuf:"bytes,2,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+}
+
+func (m *MsgAcceptInvite) Reset()         { *m = MsgAcceptInvite{} }
+func (m *MsgAcceptInvite) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptInvite) ProtoMessage()    {}
+func (*MsgAcceptInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{6}
+}
+func (m *MsgAcceptInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptInvite.Merge(m, src)
+}
+func (m *MsgAcceptInvite) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptInvite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptInvite proto.InternalMessageInfo
+
+func (m *MsgAcceptInvite) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAcceptInvite) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+type MsgAcceptInviteResponse struct {
+	// started is true when the creator was the last player to accept.
+	Started bool `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
+}
+
+func (m *MsgAcceptInviteResponse) Reset()         { *m = MsgAcceptInviteResponse{} }
+func (m *MsgAcceptInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptInviteResponse) ProtoMessage()    {}
+func (*MsgAcceptInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{7}
+}
+func (m *MsgAcceptInviteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptInviteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptInviteResponse.Merge(m, src)
+}
+func (m *MsgAcceptInviteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptInviteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptInviteResponse proto.InternalMessageInfo
+
+func (m *MsgAcceptInviteResponse) GetStarted() bool {
+	if m != nil {
+		return m.Started
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateGame)(nil), "letrongdat.checkers.checkers.MsgCreateGame")
 	proto.RegisterType((*MsgCreateGameResponse)(nil), "letrongdat.checkers.checkers.MsgCreateGameResponse")
@@ -378,39 +493,45 @@ func init() {
 	proto.RegisterType((*MsgPlayMoveResponse)(nil), "letrongdat.checkers.checkers.MsgPlayMoveResponse")
 	proto.RegisterType((*MsgRejectGame)(nil), "letrongdat.checkers.checkers.MsgRejectGame")
 	proto.RegisterType((*MsgRejectGameResponse)(nil), "letrongdat.checkers.checkers.MsgRejectGameResponse")
+	proto.RegisterType((*MsgAcceptInvite)(nil), "letrongdat.checkers.checkers.MsgAcceptInvite")
+	proto.RegisterType((*MsgAcceptInviteResponse)(nil), "letrongdat.checkers.checkers.MsgAcceptInviteResponse")
 }
 
 func init() { proto.RegisterFile("checkers/tx.proto", fileDescriptor_b89f7ca8d0309536) }
 
 var fileDescriptor_b89f7ca8d0309536 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xdd, 0x8a, 0xda, 0x50,
-	0x10, 0x36, 0xfe, 0xb5, 0x4e, 0x29, 0xb4, 0xe9, 0x5f, 0x10, 0x09, 0x92, 0x2b, 0x4b, 0x21, 0xa1,
-	0x95, 0xbe, 0x40, 0x5b, 0x10, 0xa1, 0x81, 0x12, 0x7a, 0x61, 0x7a, 0x77, 0x4c, 0xa6, 0x47, 0x57,
-	0x93, 0x13, 0x4e, 0x8e, 0xab, 0xbe, 0xc5, 0xde, 0xec, 0x3b, 0xed, 0xdd, 0x7a, 0xb9, 0x97, 0x8b,
-	0xbe, 0xc8, 0x92, 0x13, 0xf3, 0xe3, 0x2e, 0xa8, 0x7b, 0x37, 0xdf, 0x37, 0xc3, 0xcc, 0x37, 0xf3,
-	0x9d, 0x03, 0x6f, 0xbd, 0x09, 0x7a, 0x33, 0xe4, 0xb1, 0x25, 0x56, 0x66, 0xc4, 0x99, 0x60, 0x6a,
-	0x67, 0x8e, 0x82, 0xb3, 0x90, 0xfa, 0x44, 0x98, 0x59, 0x36, 0x0f, 0x0c, 0x0a, 0xaf, 0xed, 0x98,
-	0xfe, 0xe4, 0x48, 0x04, 0x0e, 0x48, 0x80, 0xaa, 0x06, 0x2f, 0xbc, 0x04, 0x31, 0xae, 0x29, 0x5d,
-	0xa5, 0xd7, 0x72, 0x32, 0xa8, 0xbe, 0x87, 0xc6, 0x78, 0x4e, 0xbc, 0x99, 0x56, 0x95, 0x7c, 0x0a,
-	0xd4, 0x37, 0x50, 0xe3, 0xe8, 0x6b, 0x35, 0xc9, 0x25, 0x61, 0x52, 0xb7, 0x24, 0x14, 0xb9, 0x56,
-	0xef, 0x2a, 0xbd, 0xba, 0x93, 0x02, 0xe3, 0x3b, 0x7c, 0x38, 0x18, 0xe4, 0x60, 0x1c, 0xb1, 0x30,
-	0x46, 0xb5, 0x03, 0x2d, 0x4a, 0x02, 0x1c, 0x86, 0x3e, 0xae, 0xf6, 0x23, 0x0b, 0xc2, 0xb8, 0x56,
-	0xe0, 0x95, 0x1d, 0xd3, 0x3f, 0x73, 0xb2, 0xb6, 0xd9, 0xe5, 0x31, 0x79, 0x07, 0x7d, 0xaa, 0x8f,
-	0xfa, 0x24, 0xa2, 0xfe, 0x73, 0x16, 0x8c, 0xa4, 0xd0, 0xba, 0x93, 0x82, 0x8c, 0x75, 0x33, 0xa9,
-	0x12, 0x24, 0x2b, 0x09, 0x36, 0xd2, 0x1a, 0x92, 0x4b, 0xc2, 0x94, 0x71, 0xb5, 0x66, 0xc6, 0xb8,
-	0xc6, 0x14, 0xde, 0x95, 0x64, 0x95, 0x97, 0xf1, 0x48, 0x24, 0x16, 0x1c, 0xfd, 0x91, 0x14, 0xd8,
-	0x70, 0x0a, 0xa2, 0x9c, 0x75, 0xa5, 0xc4, 0x52, 0xd6, 0x55, 0x3f, 0x42, 0x73, 0x39, 0x0d, 0x43,
-	0xe4, 0xfb, 0x63, 0xee, 0x91, 0x31, 0x90, 0x16, 0x39, 0x78, 0x81, 0x9e, 0x38, 0x61, 0xd1, 0xd1,
-	0x1b, 0x18, 0x9f, 0xa4, 0x05, 0x45, 0xa3, 0x4c, 0xf5, 0xb7, 0xdb, 0x2a, 0xd4, 0xec, 0x98, 0xaa,
-	0x21, 0x40, 0xe9, 0x25, 0x7c, 0x31, 0x8f, 0xbd, 0x1c, 0xf3, 0xc0, 0xcd, 0x76, 0xff, 0x19, 0xc5,
-	0xf9, 0xb5, 0x26, 0xf0, 0x32, 0x37, 0xf6, 0xf3, 0xc9, 0x06, 0x59, 0x69, 0xfb, 0xeb, 0xd9, 0xa5,
-	0xf9, 0xa4, 0x10, 0xa0, 0x74, 0xc0, 0xd3, 0x9b, 0x15, 0xc5, 0x67, 0x6c, 0xf6, 0xf4, 0xa2, 0x3f,
-	0x86, 0x37, 0x5b, 0x5d, 0xd9, 0x6c, 0x75, 0xe5, 0x7e, 0xab, 0x2b, 0x57, 0x3b, 0xbd, 0xb2, 0xd9,
-	0xe9, 0x95, 0xbb, 0x9d, 0x5e, 0xf9, 0x67, 0xd1, 0xa9, 0x98, 0x2c, 0xc6, 0xa6, 0xc7, 0x02, 0xeb,
-	0x37, 0xfe, 0x4d, 0x1a, 0xff, 0x22, 0xc2, 0xca, 0xff, 0xed, 0xaa, 0x08, 0xc5, 0x3a, 0xc2, 0x78,
-	0xdc, 0x94, 0xdf, 0xb8, 0xff, 0x10, 0x00, 0x00, 0xff, 0xff, 0x13, 0xb6, 0x3e, 0x28, 0xdb, 0x03,
-	0x00, 0x00,
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xf3, 0x6c, 0x2f, 0x20, 0xc0, 0x50, 0x3a, 0x8a, 0x2a, 0xab, 0xf2, 0xaa, 0x08, 0xe1,
+	0x08, 0xa2, 0x7e, 0x00, 0x0f, 0xa9, 0x8a, 0x84, 0x25, 0x64, 0xb1, 0x88, 0xd9, 0x4d, 0xec, 0x8b,
+	0x63, 0x1a, 0x7b, 0xac, 0x99, 0x69, 0x9a, 0x2e, 0xf9, 0x03, 0x7e, 0x83, 0x0f, 0x41, 0x62, 0xd9,
+	0x25, 0x4b, 0x94, 0xfc, 0x08, 0xf2, 0x38, 0xe3, 0xd8, 0x5d, 0x24, 0x84, 0xdd, 0x3d, 0x67, 0x8e,
+	0xce, 0x3d, 0x77, 0xe6, 0x6a, 0xe0, 0x71, 0x30, 0xc5, 0xe0, 0x12, 0xb9, 0x18, 0xc8, 0x85, 0x93,
+	0x71, 0x26, 0x99, 0x79, 0x32, 0x43, 0xc9, 0x59, 0x1a, 0x85, 0x54, 0x3a, 0xfa, 0xb4, 0x2c, 0xec,
+	0x6f, 0x06, 0x3c, 0x70, 0x45, 0xf4, 0x8e, 0x23, 0x95, 0x78, 0x41, 0x13, 0x34, 0x09, 0xf4, 0x82,
+	0x1c, 0x31, 0x4e, 0x8c, 0x53, 0xe3, 0xec, 0xd0, 0xd3, 0xd0, 0x7c, 0x0a, 0x9d, 0xc9, 0x8c, 0x06,
+	0x97, 0xa4, 0xa9, 0xf8, 0x02, 0x98, 0x8f, 0xa0, 0xc5, 0x31, 0x24, 0x2d, 0xc5, 0xe5, 0x65, 0xae,
+	0xbb, 0xa6, 0x11, 0x72, 0xd2, 0x3e, 0x35, 0xce, 0xda, 0x5e, 0x01, 0x72, 0xdf, 0x39, 0xe5, 0x31,
+	0x4d, 0x25, 0xe9, 0x14, 0xbe, 0x6b, 0x68, 0x9f, 0xc3, 0x51, 0x2d, 0x82, 0x87, 0x22, 0x63, 0xa9,
+	0x40, 0xf3, 0x04, 0x0e, 0x23, 0x9a, 0xe0, 0x28, 0x0d, 0x71, 0xb1, 0x0e, 0xb3, 0x21, 0xec, 0x1f,
+	0x06, 0xdc, 0x73, 0x45, 0xf4, 0x71, 0x46, 0x6f, 0x5c, 0x36, 0xdf, 0x16, 0xbc, 0xe6, 0xd3, 0xbc,
+	0xe3, 0x93, 0xc7, 0xfd, 0xc2, 0x59, 0x32, 0x56, 0x23, 0xb4, 0xbd, 0x02, 0x68, 0xd6, 0xd7, 0x43,
+	0x28, 0x90, 0x0f, 0x2b, 0xd9, 0x58, 0x0d, 0xd0, 0xf6, 0xf2, 0xb2, 0x60, 0x7c, 0xd2, 0xd5, 0x8c,
+	0x6f, 0x9a, 0xd0, 0x4e, 0xd8, 0x1c, 0x49, 0x4f, 0x35, 0x52, 0xb5, 0x1d, 0xc3, 0x93, 0x4a, 0xd4,
+	0xea, 0x80, 0x01, 0xcd, 0xe4, 0x15, 0xc7, 0x70, 0xac, 0x42, 0x77, 0xbc, 0x0d, 0x51, 0x3d, 0xf5,
+	0x49, 0xb3, 0x7e, 0xea, 0x9b, 0xcf, 0xa0, 0x7b, 0x1d, 0xa7, 0x29, 0xf2, 0xf5, 0xd5, 0xaf, 0x91,
+	0x7d, 0xa1, 0x1e, 0xd4, 0xc3, 0xaf, 0x18, 0xc8, 0x1d, 0x0f, 0xba, 0xf5, 0x5e, 0xec, 0x63, 0x38,
+	0xaa, 0x19, 0xe9, 0xd4, 0xf6, 0x08, 0x1e, 0xba, 0x22, 0x7a, 0x13, 0x04, 0x98, 0xc9, 0x51, 0x3a,
+	0x8f, 0xe5, 0xff, 0xf7, 0x18, 0xc2, 0xf1, 0x1d, 0xab, 0xf2, 0x6e, 0x08, 0xf4, 0x84, 0xa4, 0x5c,
+	0x62, 0xa8, 0x2c, 0x0f, 0x3c, 0x0d, 0x5f, 0xff, 0x6c, 0x41, 0xcb, 0x15, 0x91, 0x99, 0x02, 0x54,
+	0xf6, 0xf6, 0x85, 0xb3, 0x6d, 0xd1, 0x9d, 0xda, 0x86, 0xf5, 0x87, 0x7b, 0x88, 0xcb, 0x44, 0x53,
+	0x38, 0x28, 0x97, 0xed, 0xf9, 0x4e, 0x03, 0x2d, 0xed, 0xbf, 0xfa, 0x67, 0x69, 0xd9, 0x29, 0x05,
+	0xa8, 0x3c, 0xe0, 0xee, 0xc9, 0x36, 0xe2, 0xfe, 0x70, 0x0f, 0x71, 0xd9, 0x4f, 0xc2, 0xfd, 0xda,
+	0x73, 0xbe, 0xdc, 0x69, 0x52, 0x95, 0xf7, 0xcf, 0xf7, 0x92, 0xeb, 0xae, 0x6f, 0x47, 0xbf, 0x96,
+	0x96, 0x71, 0xbb, 0xb4, 0x8c, 0x3f, 0x4b, 0xcb, 0xf8, 0xbe, 0xb2, 0x1a, 0xb7, 0x2b, 0xab, 0xf1,
+	0x7b, 0x65, 0x35, 0x3e, 0x0f, 0xa2, 0x58, 0x4e, 0xaf, 0x26, 0x4e, 0xc0, 0x92, 0xc1, 0x07, 0xfc,
+	0x94, 0x5b, 0xbf, 0xa7, 0x72, 0x50, 0x7e, 0x6e, 0x8b, 0x4d, 0x29, 0x6f, 0x32, 0x14, 0x93, 0xae,
+	0xfa, 0xeb, 0x86, 0x7f, 0x07, 0x00, 0x9e, 0x34, 0x41, 0x89, 0x00, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -428,6 +549,7 @@ type MsgClient interface {
 	CreateGame(ctx context.Context, in *MsgCreateGame, opts ...grpc.CallOption) (*MsgCreateGameResponse, error)
 	PlayMove(ctx context.Context, in *MsgPlayMove, opts ...grpc.CallOption) (*MsgPlayMoveResponse, error)
 	RejectGame(ctx context.Context, in *MsgRejectGame, opts ...grpc.CallOption) (*MsgRejectGameResponse, error)
+	AcceptInvite(ctx context.Context, in *MsgAcceptInvite, opts ...grpc.CallOption) (*MsgAcceptInviteResponse, error)
 }
 
 type msgClient struct {
@@ -465,11 +587,21 @@ func (c *msgClient) RejectGame(ctx context.Context, in *MsgRejectGame, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) AcceptInvite(ctx context.Context, in *MsgAcceptInvite, opts ...grpc.CallOption) (*MsgAcceptInviteResponse, error) {
+	out := new(MsgAcceptInviteResponse)
+	err := c.cc.Invoke(ctx, "/letrongdat.checkers.checkers.Msg/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGame(context.Context, *MsgCreateGame) (*MsgCreateGameResponse, error)
 	PlayMove(context.Context, *MsgPlayMove) (*MsgPlayMoveResponse, error)
 	RejectGame(context.Context, *MsgRejectGame) (*MsgRejectGameResponse, error)
+	AcceptInvite(context.Context, *MsgAcceptInvite) (*MsgAcceptInviteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -485,6 +617,9 @@ func (*UnimplementedMsgServer) PlayMove(ctx context.Context, req *MsgPlayMove) (
 func (*UnimplementedMsgServer) RejectGame(ctx context.Context, req *MsgRejectGame) (*MsgRejectGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RejectGame not implemented")
 }
+func (*UnimplementedMsgServer) AcceptInvite(ctx context.Context, req *MsgAcceptInvite) (*MsgAcceptInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -544,6 +679,24 @@ func _Msg_RejectGame_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptInvite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/letrongdat.checkers.checkers.Msg/AcceptInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptInvite(ctx, req.(*MsgAcceptInvite))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "letrongdat.checkers.checkers.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -560,6 +713,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RejectGame",
 			Handler:    _Msg_RejectGame_Handler,
 		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _Msg_AcceptInvite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "checkers/tx.proto",
@@ -585,6 +742,13 @@ func (m *MsgCreateGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Wager != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Wager))
 		i--
@@ -664,6 +828,13 @@ func (m *MsgPlayMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Move) > 0 {
+		i -= len(m.Move)
+		copy(dAtA[i:], m.Move)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Move)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.ToY != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ToY))
 		i--
@@ -801,6 +972,76 @@ func (m *MsgRejectGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAcceptInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptInviteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptInviteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptInviteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -833,6 +1074,10 @@ func (m *MsgCreateGame) Size() (n int) {
 	if m.Wager != 0 {
 		n += 1 + sovTx(uint64(m.Wager))
 	}
+	l = len(m.Variant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -875,6 +1120,10 @@ func (m *MsgPlayMove) Size() (n int) {
 	if m.ToY != 0 {
 		n += 1 + sovTx(uint64(m.ToY))
 	}
+	l = len(m.Move)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -923,6 +1172,35 @@ func (m *MsgRejectGameResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAcceptInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptInviteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Started {
+		n += 2
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1073,6 +1351,38 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1345,6 +1655,38 @@ func (m *MsgPlayMove) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Move = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1650,6 +1992,190 @@ func (m *MsgRejectGameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAcceptInvite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptInvite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptInvite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptInviteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptInviteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptInviteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0